@@ -0,0 +1,121 @@
+package parser
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// ParseResult is the outcome of parsing one input of ParseAll or one line of
+// ParseStream. Index is the position of the input (or line, counting from zero).
+type ParseResult struct {
+	Index    int
+	Input    string
+	Passages []*BiblePassage
+	Err      error
+}
+
+// ParseAll parses every input on a pool of GOMAXPROCS workers and returns one
+// result per input, in input order. When ctx is cancelled the inputs that were not
+// parsed yet get ctx.Err() as their error.
+func (p *BiblePassageParser) ParseAll(ctx context.Context, inputs []string) []ParseResult {
+	results := make([]ParseResult, len(inputs))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = p.parseResult(ctx, i, inputs[i])
+			}
+		}()
+	}
+
+	next := 0
+dispatch:
+	for ; next < len(inputs); next++ {
+		select {
+		case jobs <- next:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	for i := next; i < len(inputs); i++ {
+		results[i] = ParseResult{Index: i, Input: inputs[i], Err: ctx.Err()}
+	}
+	return results
+}
+
+// ParseStream parses r line by line on a pool of GOMAXPROCS workers. Results are
+// sent in line order and the channel is closed once r is exhausted or ctx is
+// cancelled. A failure to read r is sent as a final result whose Err is not a
+// *ParseError. The caller must either read the channel until it is closed or
+// cancel ctx: a caller that stops reading early without cancelling leaves the
+// workers blocked for good.
+func (p *BiblePassageParser) ParseStream(ctx context.Context, r io.Reader) <-chan ParseResult {
+	out := make(chan ParseResult)
+	workers := runtime.GOMAXPROCS(0)
+	// pending holds one channel per line in read order; each is filled by a worker,
+	// so draining pending in order keeps the output ordered whatever finishes first
+	pending := make(chan chan ParseResult, workers)
+	sem := make(chan struct{}, workers)
+
+	go func() {
+		defer close(pending)
+		scanner := bufio.NewScanner(r)
+		for i := 0; scanner.Scan(); i++ {
+			// take a worker slot before queueing the line, so that every queued
+			// channel is guaranteed a worker to fill it
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			result := make(chan ParseResult, 1)
+			select {
+			case pending <- result:
+			case <-ctx.Done():
+				return
+			}
+			go func(i int, line string) {
+				defer func() { <-sem }()
+				result <- p.parseResult(ctx, i, line)
+			}(i, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			result := make(chan ParseResult, 1)
+			result <- ParseResult{Index: -1, Err: fmt.Errorf("reading input: %w", err)}
+			select {
+			case pending <- result:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	go func() {
+		defer close(out)
+		for result := range pending {
+			select {
+			case out <- <-result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (p *BiblePassageParser) parseResult(ctx context.Context, i int, input string) ParseResult {
+	if err := ctx.Err(); err != nil {
+		return ParseResult{Index: i, Input: input, Err: err}
+	}
+	passages, err := p.Parse(input)
+	return ParseResult{Index: i, Input: input, Passages: passages, Err: err}
+}
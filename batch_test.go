@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+var batchInputs = []string{"John 3:16", "Bob", "Psalm 23", "", "1 Cor 13:4-7", "Gen 1:1 - Exodus 5:2"}
+
+var batchWant = []string{"John 3:16", "error", "Psalm 23", "error", "1 Corinthians 13:4-7", "Genesis 1:1 - Exodus 5:2"}
+
+func summarise(t *testing.T, results []ParseResult) []string {
+	t.Helper()
+	got := make([]string, len(results))
+	for i, r := range results {
+		if r.Index != i {
+			t.Fatalf("result %d has index %d", i, r.Index)
+		}
+		if r.Err != nil {
+			got[i] = "error"
+			continue
+		}
+		parts := make([]string, len(r.Passages))
+		for j, pass := range r.Passages {
+			parts[j] = pass.String()
+		}
+		got[i] = strings.Join(parts, "; ")
+	}
+	return got
+}
+
+func TestParseAll(t *testing.T) {
+	p := NewBiblePassageParser()
+	results := p.ParseAll(context.Background(), batchInputs)
+	if got := summarise(t, results); !reflect.DeepEqual(got, batchWant) {
+		t.Fatalf("mismatch\n got: %#v\nwant: %#v", got, batchWant)
+	}
+	if results[1].Input != "Bob" {
+		t.Fatalf("unexpected input %q", results[1].Input)
+	}
+}
+
+func TestParseAll_Cancelled(t *testing.T) {
+	p := NewBiblePassageParser()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, r := range p.ParseAll(ctx, batchInputs) {
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Fatalf("expected context.Canceled for %q, got %v", r.Input, r.Err)
+		}
+	}
+}
+
+func TestParseStream(t *testing.T) {
+	p := NewBiblePassageParser()
+	results := []ParseResult{}
+	for r := range p.ParseStream(context.Background(), strings.NewReader(strings.Join(batchInputs, "\n"))) {
+		results = append(results, r)
+	}
+	if got := summarise(t, results); !reflect.DeepEqual(got, batchWant) {
+		t.Fatalf("mismatch\n got: %#v\nwant: %#v", got, batchWant)
+	}
+}
+
+func TestParseStream_Cancelled(t *testing.T) {
+	p := NewBiblePassageParser()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	lines := strings.Repeat("John 3:16\n", 10000)
+
+	count := 0
+	for range p.ParseStream(ctx, strings.NewReader(lines)) {
+		count++
+		if count == 10 {
+			cancel()
+		}
+	}
+	if count >= 10000 {
+		t.Fatalf("stream was not cancelled")
+	}
+}
+
+// TestParseStream_StopReading checks that cancelling ctx after reading only part of
+// the stream lets every goroutine of ParseStream finish.
+func TestParseStream_StopReading(t *testing.T) {
+	p := NewBiblePassageParser()
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	out := p.ParseStream(ctx, strings.NewReader(strings.Repeat("John 3:16\n", 10000)))
+	<-out
+	cancel()
+
+	for deadline := time.Now().Add(5 * time.Second); runtime.NumGoroutine() > before; {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines left running after cancel, had %d before", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for range out {
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("disk on fire")
+}
+
+func TestParseStream_ReadError(t *testing.T) {
+	p := NewBiblePassageParser()
+	results := []ParseResult{}
+	for r := range p.ParseStream(context.Background(), failingReader{}) {
+		results = append(results, r)
+	}
+	var pe *ParseError
+	if len(results) != 1 || results[0].Err == nil || errors.As(results[0].Err, &pe) {
+		t.Fatalf("expected a single read error, got %+v", results)
+	}
+}
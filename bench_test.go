@@ -0,0 +1,75 @@
+package parser
+
+import "testing"
+
+var benchInputs = []struct {
+	name string
+	in   string
+}{
+	{"single verse", "John 3:16"},
+	{"whole chapter", "Psalm 23"},
+	{"verse range", "1 Cor 13:4-7"},
+	{"fragments", "John 3:16b-17a"},
+	{"longhand", "John chapter 3 verse 16"},
+	{"complex list", "Gen 1:1, 3-4; 4:26-5:1; Lev 4:5; 5:2; Phlm 1:2; 1 John 1;2 John 1; 3 John; Pss 1-2"},
+}
+
+func BenchmarkParse(b *testing.B) {
+	p := NewBiblePassageParser()
+	for _, in := range benchInputs {
+		b.Run(in.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := p.Parse(in.in); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkParse_Cached(b *testing.B) {
+	p := NewBiblePassageParser(WithCache(128))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.Parse(benchInputs[i%len(benchInputs)].in); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkStandardiseString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		StandardiseString(" 1st Corinthians. ")
+	}
+}
+
+func BenchmarkExtract(b *testing.B) {
+	p := NewBiblePassageParser()
+	text := "In the sermon we read John 3:16-18 and 1 Cor. 13, then turned to Ps 23, 24 before closing with Gen 1:1 – 2:3."
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if len(p.Extract(text)) == 0 {
+			b.Fatal("no matches")
+		}
+	}
+}
+
+func BenchmarkNewBiblePassageParser(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NewBiblePassageParser()
+	}
+}
+
+func BenchmarkVerseBitset_Union(b *testing.B) {
+	p := NewBiblePassageParser()
+	x, y := p.NewVerseBitset(), p.NewVerseBitset()
+	x.Add(mustParse(b, p, "Genesis - Malachi")...)
+	y.Add(mustParse(b, p, "Psalms - Revelation")...)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Union(y)
+	}
+}
@@ -0,0 +1,124 @@
+package parser
+
+import "math/bits"
+
+// VerseBitset is a set of verses with one bit per verse ordinal (see Ordinal), about
+// 4KB for the whole Bible. Set operations work a word of 64 verses at a time, so
+// it suits analytics over many passages, such as which verses each user has read.
+// Verses count whole: adding "John 3:16a" adds John 3:16. Sets combined with Union
+// and Intersect must come from the same parser.
+type VerseBitset struct {
+	p     *BiblePassageParser
+	words []uint64
+}
+
+// NewVerseBitset returns an empty set over the verses of the parser's books.
+func (p *BiblePassageParser) NewVerseBitset() *VerseBitset {
+	n := 0
+	if len(p.ordered) > 0 {
+		last := p.ordered[len(p.ordered)-1]
+		n = last.first + last.VersesInBook()
+	}
+	return &VerseBitset{p: p, words: make([]uint64, (n+63)/64)}
+}
+
+// Add puts every verse of the passages into the set; a whole chapter given as verse
+// 0 adds all its verses. Psalm titles are not verses of the set: "Psalm 51:title"
+// alone adds nothing.
+func (s *VerseBitset) Add(passages ...*BiblePassage) {
+	for _, pass := range passages {
+		from, to := pass.From.Ordinal(), pass.To.endOrdinal()
+		if from < 0 || to < from || to >= len(s.words)*64 {
+			continue
+		}
+		for i := from / 64; i <= to/64; i++ {
+			mask := ^uint64(0)
+			if i == from/64 {
+				mask &= ^uint64(0) << (from % 64)
+			}
+			if i == to/64 {
+				mask &= ^uint64(0) >> (63 - to%64)
+			}
+			s.words[i] |= mask
+		}
+	}
+}
+
+// Contains reports whether the verse of ref is in the set. It is false for a Psalm
+// title.
+func (s *VerseBitset) Contains(ref *BibleReference) bool {
+	if ref.Superscription {
+		return false
+	}
+	n := ref.Ordinal()
+	return n >= 0 && n < len(s.words)*64 && s.words[n/64]&(1<<(n%64)) != 0
+}
+
+// Cardinality is the number of verses in the set.
+func (s *VerseBitset) Cardinality() int {
+	n := 0
+	for _, w := range s.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Union returns a new set with the verses of both sets.
+func (s *VerseBitset) Union(o *VerseBitset) *VerseBitset {
+	u := &VerseBitset{p: s.p, words: append([]uint64{}, s.words...)}
+	for i := range u.words {
+		if i < len(o.words) {
+			u.words[i] |= o.words[i]
+		}
+	}
+	return u
+}
+
+// Intersect returns a new set with the verses in both sets.
+func (s *VerseBitset) Intersect(o *VerseBitset) *VerseBitset {
+	u := &VerseBitset{p: s.p, words: make([]uint64, len(s.words))}
+	for i := range u.words {
+		if i < len(o.words) {
+			u.words[i] = s.words[i] & o.words[i]
+		}
+	}
+	return u
+}
+
+// Passages returns the set as the fewest passages, in order: each run of
+// consecutive verses is one passage, even across chapters and books.
+func (s *VerseBitset) Passages() []*BiblePassage {
+	passages := []*BiblePassage{}
+	n := len(s.words) * 64
+	for start := s.next(0, true); start < n; {
+		end := s.next(start, false)
+		from, err := s.p.ReferenceFromOrdinal(start)
+		if err != nil {
+			break
+		}
+		to, err := s.p.ReferenceFromOrdinal(end - 1)
+		if err != nil {
+			break
+		}
+		passages = append(passages, NewBiblePassage(from, to))
+		start = s.next(end, true)
+	}
+	return passages
+}
+
+// next returns the first ordinal from i on whose bit is set (or clear), or the end
+// of the set.
+func (s *VerseBitset) next(i int, set bool) int {
+	for i < len(s.words)*64 {
+		w := s.words[i/64]
+		if !set {
+			w = ^w
+		}
+		w &= ^uint64(0) << (i % 64)
+		if w != 0 {
+			return i/64*64 + bits.TrailingZeros64(w)
+		}
+		i = (i/64 + 1) * 64
+	}
+	return len(s.words) * 64
+}
@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVerseBitset(t *testing.T) {
+	p := NewBiblePassageParser()
+	set := func(s string) *VerseBitset {
+		b := p.NewVerseBitset()
+		b.Add(mustParse(t, p, s)...)
+		return b
+	}
+	strs := func(b *VerseBitset) []string {
+		out := []string{}
+		for _, pass := range b.Passages() {
+			out = append(out, pass.String())
+		}
+		return out
+	}
+
+	if got := len(p.NewVerseBitset().words) * 8; got > 4096 {
+		t.Errorf("bitset takes %d bytes, want at most 4KB", got)
+	}
+
+	cases := []struct {
+		in          string
+		cardinality int
+		want        []string
+	}{
+		{"John 3:16", 1, []string{"John 3:16"}},
+		{"John 3:16a", 1, []string{"John 3:16"}},
+		{"John 3:16-18, 3:17-20", 5, []string{"John 3:16-20"}},
+		{"John 3:36; John 4:1", 2, []string{"John 3:36-4:1"}},
+		{"Genesis 1:1", 1, []string{"Genesis 1:1"}},
+		{"Revelation 22", 21, []string{"Revelation 22"}},
+		{"Genesis 50:26; Exodus 1:1", 2, []string{"Genesis 50:26 - Exodus 1:1"}},
+		{"Romans 1-2", 32 + 29, []string{"Romans 1-2"}},
+		{"Psalm 51:title", 0, []string{}},
+		{"Psalm 51:title-2", 2, []string{"Psalm 51:1-2"}},
+		{"Psalm 50:22-51:title", 2, []string{"Psalm 50:22-23"}},
+	}
+	for _, c := range cases {
+		b := set(c.in)
+		if got := b.Cardinality(); got != c.cardinality {
+			t.Errorf("Cardinality(%s) = %d, want %d", c.in, got, c.cardinality)
+		}
+		if got := strs(b); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Passages(%s) = %q, want %q", c.in, got, c.want)
+		}
+	}
+
+	rom, _ := p.Book("Romans")
+	chapter1, _ := NewBibleReference(rom, 1, 0, "")
+	chapter2, _ := NewBibleReference(rom, 2, 0, "")
+	for _, c := range []struct {
+		pass *BiblePassage
+		want int
+	}{
+		{NewBiblePassage(chapter1, chapter1), 32},
+		{NewBiblePassage(chapter1, chapter2), 32 + 29},
+	} {
+		b := p.NewVerseBitset()
+		b.Add(c.pass)
+		if got := b.Cardinality(); got != c.want {
+			t.Errorf("Cardinality(%s) = %d, want %d", c.pass, got, c.want)
+		}
+	}
+
+	if title := mustParse(t, p, "Psalm 51:title")[0].From; set("Psalm 51:title-19").Contains(title) {
+		t.Error("a bitset holds no Psalm titles")
+	}
+
+	all := set("Genesis - Revelation")
+	if got, want := all.Cardinality(), p.Versification().Verses; got != want {
+		t.Errorf("whole Bible has %d verses, want %d", got, want)
+	}
+	if got := strs(all); !reflect.DeepEqual(got, []string{"Genesis - Revelation"}) {
+		t.Errorf("whole Bible = %q", got)
+	}
+
+	a, b := set("John 3:1-20"), set("John 3:16-4:2; Romans 8")
+	if got, want := strs(a.Union(b)), []string{"John 3:1-4:2", "Romans 8"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Union = %q, want %q", got, want)
+	}
+	if got, want := strs(a.Intersect(b)), []string{"John 3:16-20"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersect = %q, want %q", got, want)
+	}
+	if a.Cardinality() != 20 || b.Cardinality() != 21+2+39 {
+		t.Errorf("union and intersection changed their operands: %d and %d", a.Cardinality(), b.Cardinality())
+	}
+	if !b.Contains(mustParse(t, p, "Romans 8:28")[0].From) || b.Contains(mustParse(t, p, "Romans 9:1")[0].From) {
+		t.Error("Contains does not match the added passages")
+	}
+	if got := strs(p.NewVerseBitset()); len(got) != 0 {
+		t.Errorf("empty set = %q", got)
+	}
+}
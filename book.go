@@ -1,17 +1,67 @@
 package parser
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 type Book struct {
-	Number           int
-	Name             string
-	SingularName     string
-	Abbreviations    []string
+	Number        int
+	Name          string
+	SingularName  string
+	Abbreviations []string
+	// ChapterStructure maps chapter numbers to their number of verses. It is kept
+	// for compatibility: a book made by NewBook reads the ordered copy made there,
+	// so changing the map afterwards has no effect. Use ChaptersInBook,
+	// VersesInChapter and Chapters instead.
 	ChapterStructure map[int]int
+
+	// chapters holds the number of verses of each chapter, chapter 1 first, and
+	// before[i] the number of verses in the chapters ahead of chapter i+1.
+	chapters []int
+	before   []int
+	// first is the ordinal of the book's first verse among the books of its parser.
+	first int
+	// psalms marks the book of Psalms, whose chapters may open with a title.
+	psalms bool
+	// fragments is the fragment alphabet of the book's parser, nil for a book made
+	// without one.
+	fragments []rune
+
+	// next is the following book of the same parser, for passages that cross books.
+	next *Book
 }
 
+// NewBook returns a book with the chapters of chapterStructure, which must be
+// numbered from 1 without gaps; counting stops at the first missing chapter. A book
+// named "Psalms" has Psalm titles; a parser also finds Psalms by its abbreviations.
 func NewBook(number int, name, singular string, abbr []string, chapterStructure map[int]int) *Book {
-	return &Book{Number: number, Name: name, SingularName: singular, Abbreviations: abbr, ChapterStructure: chapterStructure}
+	b := &Book{Number: number, Name: name, SingularName: singular, Abbreviations: abbr, ChapterStructure: chapterStructure, psalms: name == "Psalms"}
+	b.chapters, b.before = orderChapters(chapterStructure)
+	return b
+}
+
+// orderChapters returns the verse counts of chapters 1, 2, ... up to the first
+// missing chapter, and the cumulative counts ahead of each.
+func orderChapters(chapterStructure map[int]int) (chapters, before []int) {
+	before = []int{0}
+	for ch := 1; ; ch++ {
+		n, ok := chapterStructure[ch]
+		if !ok {
+			return chapters, before
+		}
+		chapters = append(chapters, n)
+		before = append(before, before[len(before)-1]+n)
+	}
+}
+
+// layout returns the ordered chapters of the book. A Book written as a struct
+// literal has only ChapterStructure, so they are worked out from it on each call.
+func (b *Book) layout() (chapters, before []int) {
+	if b.before == nil {
+		return orderChapters(b.ChapterStructure)
+	}
+	return b.chapters, b.before
 }
 
 func (b *Book) NumberFn() int          { return b.Number }
@@ -19,13 +69,52 @@ func (b *Book) NameFn() string         { return b.Name }
 func (b *Book) SingularNameFn() string { return b.SingularName }
 
 func (b *Book) ChaptersInBook() int {
-	return len(b.ChapterStructure)
+	chapters, _ := b.layout()
+	return len(chapters)
 }
 
 func (b *Book) VersesInChapter(chapter int) (int, error) {
-	v, ok := b.ChapterStructure[chapter]
-	if !ok {
+	chapters, _ := b.layout()
+	if chapter < 1 || chapter > len(chapters) {
 		return 0, fmt.Errorf("chapter %d does not exist in %s", chapter, b.Name)
 	}
-	return v, nil
+	return chapters[chapter-1], nil
+}
+
+// Chapters returns the number of verses of each chapter, chapter 1 first.
+func (b *Book) Chapters() []int {
+	chapters, _ := b.layout()
+	return append([]int{}, chapters...)
+}
+
+// VersesInBook is the number of verses in the book.
+func (b *Book) VersesInBook() int {
+	chapters, before := b.layout()
+	return before[len(chapters)]
+}
+
+// VerseIndex returns the position of a verse within the book, counting from 0 at
+// 1:1. The distance between two verses of a book is the difference of their
+// indexes.
+func (b *Book) VerseIndex(chapter, verse int) (int, error) {
+	vmax, err := b.VersesInChapter(chapter)
+	if err != nil {
+		return 0, err
+	}
+	if verse < 1 || verse > vmax {
+		return 0, fmt.Errorf("verse %d does not exist in chapter %d of book %s", verse, chapter, b.Name)
+	}
+	_, before := b.layout()
+	return before[chapter-1] + verse - 1, nil
+}
+
+// VerseAt returns the chapter and verse at an index returned by VerseIndex, e.g.
+// to split a book into chunks of the same number of verses.
+func (b *Book) VerseAt(index int) (chapter, verse int, err error) {
+	chapters, before := b.layout()
+	if index < 0 || index >= before[len(chapters)] {
+		return 0, 0, fmt.Errorf("verse index %d is outside %s", index, b.Name)
+	}
+	i := sort.Search(len(chapters), func(i int) bool { return before[i+1] > index })
+	return i + 1, index - before[i] + 1, nil
 }
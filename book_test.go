@@ -0,0 +1,105 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gotedo/bible-chapter-verse-parser/data"
+)
+
+func TestBook_VerseIndex(t *testing.T) {
+	p := NewBiblePassageParser()
+	genesis, ruth := p.books[1], p.books[8]
+
+	if got, want := ruth.Chapters(), []int{22, 23, 18, 22}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Ruth Chapters() = %v, want %v", got, want)
+	}
+	if got := ruth.VersesInBook(); got != 85 {
+		t.Errorf("Ruth VersesInBook() = %d, want 85", got)
+	}
+	if got := genesis.VersesInBook(); got != 1533 {
+		t.Errorf("Genesis VersesInBook() = %d, want 1533", got)
+	}
+
+	cases := []struct {
+		chapter, verse, index int
+	}{
+		{1, 1, 0},
+		{1, 22, 21},
+		{2, 1, 22},
+		{3, 18, 62},
+		{4, 22, 84},
+	}
+	for _, c := range cases {
+		if got, err := ruth.VerseIndex(c.chapter, c.verse); err != nil || got != c.index {
+			t.Errorf("VerseIndex(%d, %d) = %d, %v; want %d", c.chapter, c.verse, got, err, c.index)
+		}
+		if ch, v, err := ruth.VerseAt(c.index); err != nil || ch != c.chapter || v != c.verse {
+			t.Errorf("VerseAt(%d) = %d:%d, %v; want %d:%d", c.index, ch, v, err, c.chapter, c.verse)
+		}
+	}
+
+	for i := 0; i < genesis.VersesInBook(); i++ {
+		ch, v, err := genesis.VerseAt(i)
+		if err != nil {
+			t.Fatalf("VerseAt(%d): %v", i, err)
+		}
+		if back, _ := genesis.VerseIndex(ch, v); back != i {
+			t.Fatalf("VerseIndex(VerseAt(%d)) = %d", i, back)
+		}
+	}
+
+	for _, c := range [][2]int{{0, 1}, {5, 1}, {1, 0}, {1, 23}} {
+		if _, err := ruth.VerseIndex(c[0], c[1]); err == nil {
+			t.Errorf("VerseIndex(%d, %d) succeeded, want error", c[0], c[1])
+		}
+	}
+	for _, i := range []int{-1, 85} {
+		if _, _, err := ruth.VerseAt(i); err == nil {
+			t.Errorf("VerseAt(%d) succeeded, want error", i)
+		}
+	}
+}
+
+func TestBook_ChapterStructureIsCopied(t *testing.T) {
+	chapters := map[int]int{1: 10, 2: 12}
+	b := NewBook(1, "Genesis", "Genesis", nil, chapters)
+	chapters[3] = 5
+	chapters[1] = 1
+	if n, _ := b.VersesInChapter(1); b.ChaptersInBook() != 2 || n != 10 {
+		t.Errorf("book changed with its ChapterStructure map: %d chapters, %d verses in chapter 1", b.ChaptersInBook(), n)
+	}
+}
+
+func TestWithStructure_Chapters(t *testing.T) {
+	p := NewBiblePassageParser(WithStructure(map[int]data.BookData{1: {Name: "Genesis", SingularName: "Genesis", Chapters: []int{31, 25}}}))
+	if err := p.Validate(); err != nil {
+		t.Fatalf("Validate() = %v", err)
+	}
+	if got, err := p.Normalise("Genesis 2"); err != nil || got != "Genesis 2" {
+		t.Errorf("Normalise(Genesis 2) = %q, %v", got, err)
+	}
+}
+
+func TestBook_StructLiteral(t *testing.T) {
+	b := &Book{Number: 8, Name: "Ruth", SingularName: "Ruth", ChapterStructure: map[int]int{1: 22, 2: 23, 3: 18, 4: 22}}
+	if got := b.ChaptersInBook(); got != 4 {
+		t.Errorf("ChaptersInBook() = %d, want 4", got)
+	}
+	if got := b.VersesInBook(); got != 85 {
+		t.Errorf("VersesInBook() = %d, want 85", got)
+	}
+	if ch, v, err := b.VerseAt(22); err != nil || ch != 2 || v != 1 {
+		t.Errorf("VerseAt(22) = %d:%d, %v; want 2:1", ch, v, err)
+	}
+	ref, err := NewBibleReference(b, 1, 1, "")
+	if err != nil {
+		t.Fatalf("NewBibleReference: %v", err)
+	}
+	if got := ref.Ordinal(); got != 0 {
+		t.Errorf("Ordinal() = %d, want 0", got)
+	}
+	if got := NewBiblePassage(ref, &BibleReference{Book: b, Chapter: 4, Verse: 22}).String(); got != "Ruth" {
+		t.Errorf("String() = %q, want Ruth", got)
+	}
+}
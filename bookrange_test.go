@@ -0,0 +1,55 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse_BookRanges(t *testing.T) {
+	p := NewBiblePassageParser()
+	cases := []struct {
+		in       string
+		from, to string
+		want     string
+	}{
+		{"Genesis–Deuteronomy", "Genesis 1:1", "Deuteronomy 34:12", "Genesis - Deuteronomy"},
+		{"Genesis-Deuteronomy", "Genesis 1:1", "Deuteronomy 34:12", "Genesis - Deuteronomy"},
+		{"Matt-John", "Matthew 1:1", "John 21:25", "Matthew - John"},
+		{"Romans to Jude", "Romans 1:1", "Jude 1:25", "Romans - Jude"},
+		{"Genesis - Revelation", "Genesis 1:1", "Revelation 22:21", "Genesis - Revelation"},
+		{"1 Kings 18 - 2 Kings 2", "1 Kings 18:1", "2 Kings 2:25", "1 Kings 18 - 2 Kings 2"},
+		{"Genesis 3 - Exodus", "Genesis 3:1", "Exodus 40:38", "Genesis 3 - Exodus 40"},
+		{"Genesis 50-Exodus", "Genesis 50:1", "Exodus 40:38", "Genesis 50 - Exodus 40"},
+		{"Genesis - Exodus 2", "Genesis 1:1", "Exodus 2:25", "Genesis 1 - Exodus 2"},
+		{"Genesis 49:3 - Exodus 2", "Genesis 49:3", "Exodus 2:25", "Genesis 49:3 - Exodus 2:25"},
+		{"Genesis 49:3 - Exodus", "Genesis 49:3", "Exodus 40:38", "Genesis 49:3 - Exodus 40:38"},
+		{"Genesis 50:26 - Exodus 1:1", "Genesis 50:26", "Exodus 1:1", "Genesis 50:26 - Exodus 1:1"},
+		{"Obadiah - Jonah", "Obadiah 1:1", "Jonah 4:11", "Obadiah - Jonah"},
+		{"Philemon - Jude", "Philemon 1:1", "Jude 1:25", "Philemon - Jude"},
+		{"Romans 16 - Jude 1", "Romans 16:1", "Jude 1:25", "Romans 16 - Jude 1"},
+		{"Psalm 150 - Proverbs 1", "Psalms 150:1", "Proverbs 1:33", "Psalms 150 - Proverbs 1"},
+	}
+	for _, c := range cases {
+		passages, err := p.Parse(c.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", c.in, err)
+			continue
+		}
+		got := []string{passages[0].From.String(), passages[0].To.String(), passages[0].String()}
+		if want := []string{c.from, c.to, c.want}; len(passages) != 1 || !reflect.DeepEqual(got, want) {
+			t.Errorf("Parse(%q) = %q, want %q", c.in, got, want)
+			continue
+		}
+		// the shorthand reads back as the same passage
+		back, err := p.Parse(c.want)
+		if err != nil || back[0].From.String() != c.from || back[0].To.String() != c.to {
+			t.Errorf("Parse(%q) does not round-trip: %v, %v", c.want, back, err)
+		}
+	}
+
+	for _, in := range []string{"Romans 3 to Jude 5", "Exodus - Genesis", "Genesis 3 - Exodus 41"} {
+		if _, err := p.Parse(in); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", in)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package parser
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+)
+
+// CacheStats reports how the parse cache has been used since the parser was created.
+type CacheStats struct {
+	Hits     uint64
+	Misses   uint64
+	Size     int
+	Capacity int
+}
+
+// parseCache is a size-bounded, least-recently-used cache of successful parses.
+// It stores its own copies of the passages and hands out fresh copies, so callers
+// can't change what later callers receive.
+type parseCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[string]*list.Element
+	hits     atomic.Uint64
+	misses   atomic.Uint64
+}
+
+type cacheEntry struct {
+	key      string
+	passages []*BiblePassage
+}
+
+func newParseCache(capacity int) *parseCache {
+	return &parseCache{capacity: capacity, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+func (c *parseCache) get(key string) ([]*BiblePassage, bool) {
+	c.mu.Lock()
+	var passages []*BiblePassage
+	el, ok := c.entries[key]
+	if ok {
+		c.order.MoveToFront(el)
+		passages = el.Value.(*cacheEntry).passages
+	}
+	c.mu.Unlock()
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+	c.hits.Add(1)
+	return copyPassages(passages), true
+}
+
+func (c *parseCache) put(key string, passages []*BiblePassage) {
+	passages = copyPassages(passages)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*cacheEntry).passages = passages
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, passages: passages})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *parseCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	c.entries = map[string]*list.Element{}
+}
+
+func (c *parseCache) stats() CacheStats {
+	c.mu.Lock()
+	size := c.order.Len()
+	c.mu.Unlock()
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load(), Size: size, Capacity: c.capacity}
+}
+
+// copyPassages copies passages and their references. Books are shared as they are
+// read-only.
+func copyPassages(passages []*BiblePassage) []*BiblePassage {
+	out := make([]*BiblePassage, len(passages))
+	for i, pass := range passages {
+		from, to := *pass.From, *pass.To
+		out[i] = NewBiblePassage(&from, &to)
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package parser
+
+import (
+	"testing"
+)
+
+func TestParseCache(t *testing.T) {
+	p := NewBiblePassageParser(WithCache(2))
+
+	first, err := p.Parse("John 3:16")
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	// corrupting a returned passage must not affect the cached copy
+	first[0].From.Verse = 1
+	first[0].To = first[0].From
+
+	second, err := p.Parse("John 3:16")
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if got := second[0].String(); got != "John 3:16" {
+		t.Fatalf("cached result was modified: %q", got)
+	}
+	if second[0].From == first[0].From {
+		t.Fatalf("cache returned the same reference twice")
+	}
+
+	if _, err := p.Parse("Bob"); err == nil {
+		t.Fatalf("expected error for invalid input")
+	}
+	p.Parse("Psalm 23")
+	p.Parse("Gen 1")
+	p.Parse("John 3:16")
+
+	want := CacheStats{Hits: 1, Misses: 5, Size: 2, Capacity: 2}
+	if got := p.CacheStats(); got != want {
+		t.Fatalf("stats mismatch\n got: %+v\nwant: %+v", got, want)
+	}
+
+	p.Parse("Gen 1")
+	if got := p.CacheStats(); got.Hits != 2 {
+		t.Fatalf("expected least recently used entry to be kept, got %+v", got)
+	}
+}
+
+func TestParseCache_Disabled(t *testing.T) {
+	p := NewBiblePassageParser(WithCache(0))
+	p.Parse("John 3:16")
+	if got := p.CacheStats(); got != (CacheStats{}) {
+		t.Fatalf("expected empty stats without a cache, got %+v", got)
+	}
+}
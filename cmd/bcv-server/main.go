@@ -0,0 +1,73 @@
+// Command bcv-server serves the Bible chapter/verse parser as a JSON HTTP API.
+//
+//	bcv-server -addr :8080
+//
+// See package server for the available endpoints.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	parser "github.com/gotedo/bible-chapter-verse-parser"
+	"github.com/gotedo/bible-chapter-verse-parser/server"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	cacheSize := flag.Int("cache-size", 10000, "number of distinct inputs kept in the parse cache (0 disables it)")
+	strict := flag.Bool("strict", false, "reject ambiguous input instead of interpreting it")
+	structure := flag.String("structure", "", "JSON, CSV or YAML file with the book table to use instead of the built-in one")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 10*time.Second, "time allowed for in-flight requests on shutdown")
+	flag.Parse()
+
+	opts := []parser.Option{parser.WithCache(*cacheSize)}
+	if *strict {
+		opts = append(opts, parser.WithStrict())
+	}
+	if *structure != "" {
+		f, err := os.Open(*structure)
+		if err != nil {
+			log.Fatal(err)
+		}
+		books, err := parser.LoadStructure(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("%s: %v", *structure, err)
+		}
+		opts = append(opts, parser.WithStructure(books))
+	}
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           server.NewHandler(parser.NewBiblePassageParser(opts...)),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		IdleTimeout:       60 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		log.Printf("bcv-server listening on %s", *addr)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal(err)
+		}
+	}()
+
+	<-ctx.Done()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Printf("shutdown: %v", err)
+	}
+}
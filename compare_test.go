@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestReference_Compare(t *testing.T) {
+	p := NewBiblePassageParser()
+	ref := func(s string) *BibleReference {
+		t.Helper()
+		got, err := p.Parse(s)
+		if err != nil {
+			t.Fatalf("parse %q: %v", s, err)
+		}
+		return got[0].From
+	}
+
+	// each reference sorts strictly before the next
+	ordered := []string{"Psalm 51:title", "Psalm 51:1", "Mark 1:4", "Mark 1:4a", "Mark 1:4b", "Mark 1:5", "Mark 2:1", "Luke 1:1"}
+	for i := 0; i+1 < len(ordered); i++ {
+		a, b := ref(ordered[i]), ref(ordered[i+1])
+		if a.Compare(b) != -1 || b.Compare(a) != 1 || !a.Less(b) || b.Less(a) || a.Equal(b) {
+			t.Errorf("%q must sort before %q", ordered[i], ordered[i+1])
+		}
+	}
+	if a, b := ref("Mark 1:4B"), ref("Mk 1:4b"); !a.Equal(b) || a.Compare(b) != 0 {
+		t.Error("the same fragment must compare equal")
+	}
+}
+
+func TestParse_FragmentOrder(t *testing.T) {
+	p := NewBiblePassageParser()
+	for _, in := range []string{"John 3:16a-16b", "John 3:16a-16", "John 3:16-16a", "John 3:16b-17a"} {
+		if _, err := p.Parse(in); err != nil {
+			t.Errorf("parse %q: %v", in, err)
+		}
+	}
+	_, err := p.Parse("John 3:16b-16a")
+	if err == nil || err.Error() != "references end is before beginning" {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestPassage_Validate(t *testing.T) {
+	p := NewBiblePassageParser()
+	john, _ := p.Book("John")
+	ref := func(verse int, fragment string) *BibleReference {
+		return &BibleReference{Book: john, Chapter: 3, Verse: verse, Fragment: fragment}
+	}
+	cases := []struct {
+		from, to *BibleReference
+		valid    bool
+	}{
+		{ref(16, ""), ref(17, ""), true},
+		{ref(16, "a"), ref(16, ""), true},
+		{ref(16, ""), ref(16, "a"), true},
+		{ref(17, ""), ref(16, ""), false},
+		{ref(16, "b"), ref(16, "a"), false},
+	}
+	for _, c := range cases {
+		pass := NewBiblePassage(c.from, c.to)
+		if err := pass.Validate(); (err == nil) != c.valid {
+			t.Errorf("Validate(%s - %s) = %v, want valid %v", c.from, c.to, err, c.valid)
+		}
+	}
+}
+
+func TestPassages_Sort(t *testing.T) {
+	p := NewBiblePassageParser()
+	passages, err := p.Parse("Mark 1:5; Mark 1:4b; Mark 1:4-6; Mark 1:4; Gen 1; Mark 1:4a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Sort(Passages(passages))
+	want := []string{"Genesis 1", "Mark 1:4", "Mark 1:4-6", "Mark 1:4a", "Mark 1:4b", "Mark 1:5"}
+	for i, pass := range passages {
+		if pass.String() != want[i] {
+			t.Errorf("position %d: got %q, want %q", i, pass.String(), want[i])
+		}
+	}
+}
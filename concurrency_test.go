@@ -0,0 +1,127 @@
+package parser
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// TestParser_Concurrent shares one parser between goroutines that parse, extract
+// and register abbreviations at the same time. Run with -race.
+func TestParser_Concurrent(t *testing.T) {
+	p := NewBiblePassageParser(WithCache(2))
+
+	inputs := map[string]string{
+		"John 3:16":                  "John 3:16",
+		"Psalm 23":                   "Psalm 23",
+		"1 Cor 13:4-7; 2 Cor 5":      "1 Corinthians 13:4-7; 2 Corinthians 5",
+		"Gen 1:1 - Exodus 5:2 & 6:3": "Genesis 1:1 - Exodus 5:2; Exodus 6:3",
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 64)
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				for in, want := range inputs {
+					got, err := p.Normalise(in)
+					if err != nil || got != want {
+						errs <- fmt.Errorf("Normalise(%q) = %q, %v; want %q", in, got, err, want)
+						return
+					}
+				}
+				if len(p.Extract("Read John 3:16 today")) != 1 {
+					errs <- fmt.Errorf("Extract found nothing")
+					return
+				}
+				if g%4 == 0 {
+					if err := p.AddAbbreviation(fmt.Sprintf("gospel %c", 'a'+g), "John"); err != nil {
+						errs <- err
+						return
+					}
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	got, err := p.Normalise("Gospel e 3:16")
+	if err != nil || got != "John 3:16" {
+		t.Fatalf("registered abbreviation not used: %q, %v", got, err)
+	}
+}
+
+func TestParser_AddAbbreviation(t *testing.T) {
+	p := NewBiblePassageParser()
+
+	if err := p.AddAbbreviation("Yoh", "John"); err != nil {
+		t.Fatalf("AddAbbreviation error: %v", err)
+	}
+	if got, err := p.Normalise("Yoh 3:16"); err != nil || got != "John 3:16" {
+		t.Fatalf("got %q, %v", got, err)
+	}
+	if m := p.Extract("Read Yoh 3:16"); len(m) != 1 {
+		t.Fatalf("extract did not pick up the new abbreviation: %v", m)
+	}
+
+	cases := []struct{ abbr, book string }{
+		{"Gen", "John"},
+		{"Yo", "Bob"},
+		{"...", "John"},
+		{"Jn1", "John"},
+		{"v", "Genesis"},
+		{"To", "Genesis"},
+		{"ff", "Genesis"},
+		{"title", "Genesis"},
+		{"and", "Genesis"},
+		{"end", "Genesis"},
+	}
+	for _, c := range cases {
+		if err := p.AddAbbreviation(c.abbr, c.book); err == nil {
+			t.Fatalf("expected error for AddAbbreviation(%q, %q)", c.abbr, c.book)
+		}
+	}
+	for _, in := range []string{"John 3 v 16", "John 3:16 - end", "Genesis 1:1 to 2:3"} {
+		if _, err := p.Parse(in); err != nil {
+			t.Errorf("keywords broken by rejected abbreviations: %q: %v", in, err)
+		}
+	}
+}
+
+func TestParser_AddAbbreviation_Unicode(t *testing.T) {
+	p := NewBiblePassageParser()
+	for abbr, book := range map[string]string{"Génesis": "Genesis", "Éxodo": "Exodus", "Иоанна": "John"} {
+		if err := p.AddAbbreviation(abbr, book); err != nil {
+			t.Fatalf("AddAbbreviation(%q) error: %v", abbr, err)
+		}
+	}
+	cases := map[string]string{
+		"Génesis 1:1":   "Genesis 1:1",
+		"GÉNESIS 1:1":   "Genesis 1:1",
+		"Éxodo 3:14":    "Exodus 3:14",
+		"Иоанна 3:16":   "John 3:16",
+		"Genesis 1:1":   "Genesis 1:1",
+		"Gen 1:1-2:3":   "Genesis 1:1-2:3",
+		"Génesis 1 - 2": "Genesis 1-2",
+	}
+	for in, want := range cases {
+		if got, err := p.Normalise(in); err != nil || got != want {
+			t.Errorf("Normalise(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if m := p.Extract("Lea Génesis 1:1 hoy"); len(m) != 1 || m[0].Text != "Génesis 1:1" {
+		t.Errorf("Extract did not pick up the accented abbreviation: %v", m)
+	}
+	if err := p.AddAbbreviation("Génesis", "Exodus"); err == nil {
+		t.Error("AddAbbreviation accepted an accented abbreviation of another book")
+	}
+	if got := StandardiseString(" Génesis. "); got != "génesis" {
+		t.Errorf("StandardiseString = %q, want %q", got, "génesis")
+	}
+}
@@ -0,0 +1,145 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseContext is what a reference may leave out, such as the book and chapter of
+// a heading ("Sermon on John 3") that notes below it refer to as "v16" or "4:1-5".
+// Chapter and Verse are optional (zero). Bare numbers follow the same rules as in
+// a list: after a chapter they are chapters, after a verse they are verses.
+type ParseContext struct {
+	Book    *Book
+	Chapter int
+	Verse   int
+}
+
+// ContextFrom returns the context left by ref, as if ref had been written just
+// before the text to parse.
+func ContextFrom(ref *BibleReference) ParseContext {
+	return ParseContext{Book: ref.Book, Chapter: ref.Chapter, Verse: ref.Verse}
+}
+
+// state returns the context as the parser's last book, chapter and verse.
+func (c ParseContext) state() (*Book, *int, *int) {
+	var chapter, verse *int
+	if c.Chapter > 0 {
+		ch := c.Chapter
+		chapter = &ch
+	}
+	if c.Verse > 0 {
+		v := c.Verse
+		verse = &v
+	}
+	return c.Book, chapter, verse
+}
+
+// resolve checks ctx against the parser's books and replaces ctx.Book with the
+// parser's own instance, as passages compare books by pointer.
+func (p *BiblePassageParser) resolve(ctx ParseContext) (ParseContext, error) {
+	if ctx.Book == nil {
+		if ctx.Chapter != 0 || ctx.Verse != 0 {
+			return ctx, fmt.Errorf("invalid context: chapter without a book")
+		}
+		return ctx, nil
+	}
+	b, ok := p.books[ctx.Book.Number]
+	if !ok {
+		return ctx, fmt.Errorf("invalid context: unknown book %s", ctx.Book.Name)
+	}
+	ctx.Book = b
+	if ctx.Verse != 0 && ctx.Chapter == 0 {
+		return ctx, fmt.Errorf("invalid context: verse without a chapter")
+	}
+	if ctx.Chapter != 0 {
+		if _, err := NewBibleReference(b, ctx.Chapter, ctx.Verse, ""); err != nil || ctx.Chapter < 0 || ctx.Verse < 0 {
+			return ctx, fmt.Errorf("invalid context: %s %d:%d does not exist", b.Name, ctx.Chapter, ctx.Verse)
+		}
+	}
+	return ctx, nil
+}
+
+// ParseWithContext is Parse for text that may leave out the book, chapter or verse
+// supplied by ctx: with ParseContext{Book: john, Chapter: 3}, "v16", "vv. 16-18" and
+// "4:1-5" are John 3:16, John 3:16-18 and John 4:1-5. A book in the text overrides
+// the context. Results are not cached. An invalid ctx is reported as a plain error.
+func (p *BiblePassageParser) ParseWithContext(versesString string, ctx ParseContext) ([]*BiblePassage, error) {
+	ctx, err := p.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return p.parse(versesString, ctx, nil)
+}
+
+// buildContextRegex builds the finder for references without a book: verses
+// introduced by "v", "vv" or "verse(s)" and chapter:verse pairs.
+func buildContextRegex(fragments []rune) *regexp.Regexp {
+	number := `\d+[` + regexp.QuoteMeta(string(fragments)) + `]?`
+	verse := `(?:vv?\.?|verses?)\s*` + number + `(?:\s*ff?\b)?`
+	chapterVerse := `\d+:` + number + `(?:\s*ff?\b)?`
+	rangeEnd := `(?:\s*[-–—]\s*(?:\d+:)?` + number + `)?`
+	item := `(?:` + verse + `|` + chapterVerse + `)` + rangeEnd
+	return regexp.MustCompile(`(?i)\b` + item + `(?:\s*[,;&]\s*(?:` + item + `|` + number + rangeEnd + `))*\b`)
+}
+
+// listGap matches the text allowed between a reference and a bare chapter:verse
+// that continues it, as in "John 3:16 and 4:1".
+var listGap = regexp.MustCompile(`(?i)^[\s,;&]*(?:and\s*)?$`)
+
+// ExtractWithContext is Extract for a document whose references lean on the ones
+// before them. It also finds references without a book ("v16", "vv. 16-18",
+// "4:1-5") and reads each match in the context left by the previous one, starting
+// from ctx. Bookless references before any context are skipped. A bare
+// chapter:verse is only taken right after another match ("John 3:16 and 4:1"), so
+// a time of day such as "at 10:30" is not read as a verse.
+func (p *BiblePassageParser) ExtractWithContext(text string, ctx ParseContext) ([]*Match, error) {
+	ctx, err := p.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type candidate struct {
+		loc    []int
+		booked bool
+	}
+	candidates := []candidate{}
+	booked := p.extractPattern().FindAllStringIndex(text, -1)
+	for _, loc := range booked {
+		candidates = append(candidates, candidate{loc, true})
+	}
+	for _, loc := range p.contextRegex.FindAllStringIndex(text, -1) {
+		// a bookless candidate inside a full reference ("John 4:1") is not a second match
+		i := sort.Search(len(booked), func(i int) bool { return booked[i][1] > loc[0] })
+		if i < len(booked) && booked[i][0] < loc[1] {
+			continue
+		}
+		candidates = append(candidates, candidate{loc, false})
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].loc[0] < candidates[j].loc[0] })
+
+	matches := []*Match{}
+	for _, c := range candidates {
+		found := text[c.loc[0]:c.loc[1]]
+		r, _ := utf8.DecodeRuneInString(found)
+		if c.booked && unicode.IsLetter(r) && !unicode.IsUpper(r) {
+			continue
+		}
+		if !c.booked && unicode.IsDigit(r) {
+			// a chapter:verse without a verse marker must continue the previous match
+			if n := len(matches); n == 0 || !listGap.MatchString(text[matches[n-1].End:c.loc[0]]) {
+				continue
+			}
+		}
+		passages, err := p.parse(found, ctx, nil)
+		if err != nil {
+			continue
+		}
+		matches = append(matches, &Match{Text: found, Start: c.loc[0], End: c.loc[1], Passages: passages})
+		ctx = ContextFrom(passages[len(passages)-1].To)
+	}
+	return matches, nil
+}
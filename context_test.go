@@ -0,0 +1,138 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseWithContext(t *testing.T) {
+	p := NewBiblePassageParser()
+	john, _ := p.Book("John")
+	ps, _ := p.Book("Psalms")
+	verse, _ := NewBibleReference(john, 3, 16, "")
+
+	cases := []struct {
+		name string
+		ctx  ParseContext
+		in   string
+		want [][]string
+	}{
+		{"verse marker", ParseContext{Book: john, Chapter: 3}, "v16", [][]string{{"John 3:16", "John 3:16"}}},
+		{"verses marker", ParseContext{Book: john, Chapter: 3}, "vv. 16-18", [][]string{{"John 3:16", "John 3:18"}}},
+		{"verse word with fragment", ParseContext{Book: john, Chapter: 3}, "verse 16b", [][]string{{"John 3:16b", "John 3:16b"}}},
+		{"chapter and verse", ParseContext{Book: john, Chapter: 3}, "4:1-5", [][]string{{"John 4:1", "John 4:5"}}},
+		{"book only", ParseContext{Book: john}, "4:1-5", [][]string{{"John 4:1", "John 4:5"}}},
+		{"bare number after a chapter", ParseContext{Book: john, Chapter: 3}, "5", [][]string{{"John 5:1", "John 5:47"}}},
+		{"bare number after a verse", ContextFrom(verse), "18", [][]string{{"John 3:18", "John 3:18"}}},
+		{"list", ParseContext{Book: john, Chapter: 3}, "v16, 18 & 4:1", [][]string{{"John 3:16", "John 3:16"}, {"John 3:18", "John 3:18"}, {"John 4:1", "John 4:1"}}},
+		{"book overrides context", ParseContext{Book: john, Chapter: 3}, "Rom 8:28", [][]string{{"Romans 8:28", "Romans 8:28"}}},
+		{"psalm title", ParseContext{Book: ps, Chapter: 51}, "v0", [][]string{{"Psalms 51:title", "Psalms 51:title"}}},
+		{"no context needed", ParseContext{}, "John 3:16", [][]string{{"John 3:16", "John 3:16"}}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := p.ParseWithContext(c.in, c.ctx)
+			if err != nil {
+				t.Fatalf("parse error for %q: %v", c.in, err)
+			}
+			gotPairs := make([][]string, len(got))
+			for i, pass := range got {
+				gotPairs[i] = []string{pass.From.String(), pass.To.String()}
+			}
+			if !reflect.DeepEqual(gotPairs, c.want) {
+				t.Fatalf("mismatch for %q\n got: %#v\nwant: %#v", c.in, gotPairs, c.want)
+			}
+		})
+	}
+}
+
+func TestParseWithContext_Invalid(t *testing.T) {
+	p := NewBiblePassageParser()
+	john, _ := p.Book("John")
+	cases := []struct {
+		name string
+		ctx  ParseContext
+		in   string
+	}{
+		{"no context", ParseContext{}, "v16"},
+		{"no chapter", ParseContext{Book: john}, "v16"},
+		{"chapter without book", ParseContext{Chapter: 3}, "v16"},
+		{"missing chapter", ParseContext{Book: john, Chapter: 22}, "v16"},
+		{"missing verse", ParseContext{Book: john, Chapter: 3, Verse: 37}, "18"},
+		{"verse outside chapter", ParseContext{Book: john, Chapter: 3}, "v37"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := p.ParseWithContext(c.in, c.ctx); err == nil {
+				t.Fatalf("expected error for %q", c.in)
+			}
+		})
+	}
+	if _, err := p.Parse("v16"); err == nil {
+		t.Error("Parse must not accept a verse without a book")
+	}
+}
+
+func TestExtractWithContext(t *testing.T) {
+	p := NewBiblePassageParser()
+	text := "Sermon on John 3. Start at v16, then vv. 17-18 & 21, and 4:1-5. Later 4:6 and Rom 8:28; see v. 31. At 2:30 we pray, as 2:30 is past the end of Romans 2."
+	got, err := p.ExtractWithContext(text, ParseContext{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ text, normalised string }{
+		{"John 3", "John 3"},
+		{"v16", "John 3:16"},
+		{"vv. 17-18 & 21", "John 3:17-18; John 3:21"},
+		{"4:1-5", "John 4:1-5"},
+		{"Rom 8:28", "Romans 8:28"},
+		{"v. 31", "Romans 8:31"},
+		{"Romans 2", "Romans 2"},
+	}
+	if len(got) != len(want) {
+		for _, m := range got {
+			t.Logf("%q", m.Text)
+		}
+		t.Fatalf("got %d matches, want %d", len(got), len(want))
+	}
+	for i, m := range got {
+		if m.Text != want[i].text || text[m.Start:m.End] != m.Text {
+			t.Errorf("match %d: got %q, want %q", i, m.Text, want[i].text)
+		}
+		if n := joinPassages(m.Passages); n != want[i].normalised {
+			t.Errorf("match %d: got %q, want %q", i, n, want[i].normalised)
+		}
+	}
+
+	john, _ := p.Book("John")
+	got, err = p.ExtractWithContext("Read v16 first.", ParseContext{Book: john, Chapter: 3})
+	if err != nil || len(got) != 1 || joinPassages(got[0].Passages) != "John 3:16" {
+		t.Errorf("got %v, %v", got, err)
+	}
+	if got, _ := p.ExtractWithContext("Read v16 first.", ParseContext{}); len(got) != 0 {
+		t.Errorf("bookless reference without context: got %d matches", len(got))
+	}
+	// a time of day is not a chapter and verse, even where the chapter exists
+	for _, text := range []string{"We met at 10:30 to read.", "Read John 3. We met at 10:30 after v16."} {
+		got, err := p.ExtractWithContext(text, ParseContext{Book: john, Chapter: 3})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range got {
+			if m.Text == "10:30" {
+				t.Errorf("ExtractWithContext(%q) took %q as %s", text, m.Text, joinPassages(m.Passages))
+			}
+		}
+	}
+}
+
+func joinPassages(passages []*BiblePassage) string {
+	out := ""
+	for i, pass := range passages {
+		if i > 0 {
+			out += "; "
+		}
+		out += pass.String()
+	}
+	return out
+}
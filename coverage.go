@@ -0,0 +1,156 @@
+package parser
+
+import "sort"
+
+// CoverageReport describes how much of the Bible a collection of passages covers,
+// such as a year of sermons. Verses count as covered when any part of them is, and
+// Psalm titles are not counted. It marshals to JSON as is.
+type CoverageReport struct {
+	Verses  int     `json:"verses"`
+	Covered int     `json:"covered"`
+	Percent float64 `json:"percent"`
+
+	Testaments []TestamentCoverage `json:"testaments"`
+	Books      []BookCoverage      `json:"books"`
+	// Heat counts how many passages include each covered verse, in canonical order.
+	Heat []VerseHeat `json:"heat"`
+	// MostRepeated lists the passages given more than once, most repeated first.
+	MostRepeated []PassageCount `json:"most_repeated"`
+	// Uncovered lists the chapters no passage touches.
+	Uncovered []ChapterName `json:"uncovered"`
+}
+
+type TestamentCoverage struct {
+	Name    string  `json:"name"`
+	Verses  int     `json:"verses"`
+	Covered int     `json:"covered"`
+	Percent float64 `json:"percent"`
+}
+
+type BookCoverage struct {
+	Book     string            `json:"book"`
+	Verses   int               `json:"verses"`
+	Covered  int               `json:"covered"`
+	Percent  float64           `json:"percent"`
+	Chapters []ChapterCoverage `json:"chapters"`
+}
+
+type ChapterCoverage struct {
+	Chapter int     `json:"chapter"`
+	Verses  int     `json:"verses"`
+	Covered int     `json:"covered"`
+	Percent float64 `json:"percent"`
+}
+
+type VerseHeat struct {
+	Book    string `json:"book"`
+	Chapter int    `json:"chapter"`
+	Verse   int    `json:"verse"`
+	Count   int    `json:"count"`
+}
+
+type PassageCount struct {
+	Passage string `json:"passage"`
+	Count   int    `json:"count"`
+}
+
+type ChapterName struct {
+	Book    string `json:"book"`
+	Chapter int    `json:"chapter"`
+}
+
+// testament names the testament of a book of the Protestant canon, or "" for
+// other books.
+func testament(number int) string {
+	switch {
+	case number >= 1 && number <= 39:
+		return "Old Testament"
+	case number >= 40 && number <= 66:
+		return "New Testament"
+	}
+	return ""
+}
+
+func percent(covered, verses int) float64 {
+	if verses == 0 {
+		return 0
+	}
+	return 100 * float64(covered) / float64(verses)
+}
+
+// Coverage reports how much of the parser's books the passages cover. Passages are
+// matched to books by number, so they may come from another parser.
+func (p *BiblePassageParser) Coverage(passages []*BiblePassage) *CoverageReport {
+	heat := map[verseKey]int{}
+	repeats := map[string]int{}
+	var distinct Passages
+	for _, pass := range passages {
+		s := pass.String()
+		if repeats[s] == 0 {
+			distinct = append(distinct, pass)
+		}
+		repeats[s]++
+		for _, ref := range pass.Verses() {
+			if !ref.Superscription {
+				heat[verseKey{ref.Book.Number, ref.Chapter, ref.Verse}]++
+			}
+		}
+	}
+
+	r := &CoverageReport{Testaments: []TestamentCoverage{}, Books: []BookCoverage{}, Heat: []VerseHeat{}, MostRepeated: []PassageCount{}, Uncovered: []ChapterName{}}
+	testaments := map[string]int{}
+	for _, b := range p.Books() {
+		bc := BookCoverage{Book: b.Name, Chapters: []ChapterCoverage{}}
+		for ch := 1; ch <= b.ChaptersInBook(); ch++ {
+			vmax, _ := b.VersesInChapter(ch)
+			cc := ChapterCoverage{Chapter: ch, Verses: vmax}
+			for v := 1; v <= vmax; v++ {
+				if n := heat[verseKey{b.Number, ch, v}]; n > 0 {
+					cc.Covered++
+					r.Heat = append(r.Heat, VerseHeat{b.Name, ch, v, n})
+				}
+			}
+			cc.Percent = percent(cc.Covered, cc.Verses)
+			if cc.Covered == 0 {
+				r.Uncovered = append(r.Uncovered, ChapterName{b.Name, ch})
+			}
+			bc.Chapters = append(bc.Chapters, cc)
+			bc.Verses += cc.Verses
+			bc.Covered += cc.Covered
+		}
+		bc.Percent = percent(bc.Covered, bc.Verses)
+		r.Books = append(r.Books, bc)
+		r.Verses += bc.Verses
+		r.Covered += bc.Covered
+
+		if name := testament(b.Number); name != "" {
+			i, ok := testaments[name]
+			if !ok {
+				i = len(r.Testaments)
+				testaments[name] = i
+				r.Testaments = append(r.Testaments, TestamentCoverage{Name: name})
+			}
+			t := &r.Testaments[i]
+			t.Verses += bc.Verses
+			t.Covered += bc.Covered
+		}
+	}
+	r.Percent = percent(r.Covered, r.Verses)
+	for i := range r.Testaments {
+		r.Testaments[i].Percent = percent(r.Testaments[i].Covered, r.Testaments[i].Verses)
+	}
+
+	sort.Sort(distinct)
+	for _, pass := range distinct {
+		if s := pass.String(); repeats[s] > 1 {
+			r.MostRepeated = append(r.MostRepeated, PassageCount{s, repeats[s]})
+		}
+	}
+	sort.SliceStable(r.MostRepeated, func(i, j int) bool { return r.MostRepeated[i].Count > r.MostRepeated[j].Count })
+	return r
+}
+
+// verseKey identifies a verse by book number, chapter and verse.
+type verseKey struct {
+	book, chapter, verse int
+}
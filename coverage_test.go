@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCoverage(t *testing.T) {
+	p := NewBiblePassageParser()
+	var passages []*BiblePassage
+	for _, s := range []string{"Jude", "Jude 1:3-5", "Jude 1:3-5", "John 3:16a", "John 3:16", "Psalm 51:title-2", "3 John", "Jude 1:3-5"} {
+		passages = append(passages, mustParse(t, p, s)...)
+	}
+	r := p.Coverage(passages)
+
+	total := 0
+	for _, b := range p.Books() {
+		total += b.VersesInBook()
+	}
+	if r.Verses != total {
+		t.Errorf("Verses = %d, want %d", r.Verses, total)
+	}
+	if want := 25 + 1 + 2 + 15; r.Covered != want {
+		t.Errorf("Covered = %d, want %d", r.Covered, want)
+	}
+	if len(r.Books) != 66 || len(r.Testaments) != 2 {
+		t.Fatalf("got %d books and %d testaments, want 66 and 2", len(r.Books), len(r.Testaments))
+	}
+	if ot := r.Testaments[0]; ot.Name != "Old Testament" || ot.Covered != 2 {
+		t.Errorf("Testaments[0] = %+v, want Old Testament with 2 verses covered", ot)
+	}
+	if nt := r.Testaments[1]; nt.Name != "New Testament" || nt.Covered != 41 {
+		t.Errorf("Testaments[1] = %+v, want New Testament with 41 verses covered", nt)
+	}
+
+	jude := r.Books[64]
+	if jude.Book != "Jude" || jude.Percent != 100 || len(jude.Chapters) != 1 {
+		t.Errorf("Jude = %+v, want all 25 verses covered", jude)
+	}
+	john := r.Books[42].Chapters[2]
+	if john.Chapter != 3 || john.Verses != 36 || john.Covered != 1 {
+		t.Errorf("John 3 = %+v, want 1 of 36 verses covered", john)
+	}
+
+	heat := map[string]int{}
+	for _, h := range r.Heat {
+		heat[fmt.Sprintf("%s %d:%d", h.Book, h.Chapter, h.Verse)] = h.Count
+	}
+	for ref, want := range map[string]int{"Jude 1:2": 1, "Jude 1:4": 4, "John 3:16": 2, "Psalms 51:1": 1, "Psalms 51:3": 0} {
+		if heat[ref] != want {
+			t.Errorf("heat of %s = %d, want %d", ref, heat[ref], want)
+		}
+	}
+
+	wantRepeated := []PassageCount{{"Jude 1:3-5", 3}}
+	if !reflect.DeepEqual(r.MostRepeated, wantRepeated) {
+		t.Errorf("MostRepeated = %v, want %v", r.MostRepeated, wantRepeated)
+	}
+
+	if got, want := len(r.Uncovered), 1189-4; got != want {
+		t.Errorf("%d uncovered chapters, want %d", got, want)
+	}
+	if r.Uncovered[0] != (ChapterName{"Genesis", 1}) {
+		t.Errorf("Uncovered[0] = %v, want Genesis 1", r.Uncovered[0])
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var back CoverageReport
+	if err := json.Unmarshal(b, &back); err != nil || !reflect.DeepEqual(&back, r) {
+		t.Errorf("report does not round-trip through JSON: %v", err)
+	}
+}
+
+func TestCoverage_Empty(t *testing.T) {
+	r := NewBiblePassageParser().Coverage(nil)
+	if r.Covered != 0 || r.Percent != 0 || len(r.Heat) != 0 || len(r.Uncovered) != 1189 {
+		t.Errorf("empty coverage = %d covered, %v%%, %d hot verses, %d uncovered chapters", r.Covered, r.Percent, len(r.Heat), len(r.Uncovered))
+	}
+}
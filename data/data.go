@@ -0,0 +1,32 @@
+// Package data holds the book table the parser is built from.
+package data
+
+//go:generate go run ../internal/genstructure -in bible_structure.json -out static_bible_structure.go
+
+type BookData struct {
+	Name          string
+	SingularName  string
+	Abbreviations []string
+	// Chapters is the number of verses of each chapter, starting with chapter 1.
+	Chapters []int
+	// ChapterStructure maps chapter numbers to their number of verses. For
+	// BibleStructure it is filled from Chapters.
+	ChapterStructure map[int]int
+}
+
+// ChapterMap returns Chapters in the form of ChapterStructure, keyed by chapter
+// number from 1.
+func (bd BookData) ChapterMap() map[int]int {
+	m := make(map[int]int, len(bd.Chapters))
+	for i, n := range bd.Chapters {
+		m[i+1] = n
+	}
+	return m
+}
+
+func init() {
+	for num, bd := range BibleStructure {
+		bd.ChapterStructure = bd.ChapterMap()
+		BibleStructure[num] = bd
+	}
+}
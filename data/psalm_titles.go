@@ -0,0 +1,21 @@
+package data
+
+// PsalmTitles lists the Psalms that open with a superscription (title), such as
+// "A Psalm of David, when he fled from Absalom his son." The value is the number of
+// verses the title takes in the Hebrew (Masoretic) numbering. English Bibles never
+// number titles; the Hebrew either counts the title as part of verse 1 (0) or gives
+// it one or two verses of its own, shifting every following verse by that amount.
+var PsalmTitles = map[int]int{
+	3: 1, 4: 1, 5: 1, 6: 1, 7: 1, 8: 1, 9: 1, 11: 0, 12: 1, 13: 1,
+	14: 0, 15: 0, 16: 0, 17: 0, 18: 1, 19: 1, 20: 1, 21: 1, 22: 1, 23: 0,
+	24: 0, 25: 0, 26: 0, 27: 0, 28: 0, 29: 0, 30: 1, 31: 1, 32: 0, 34: 1,
+	35: 0, 36: 1, 37: 0, 38: 1, 39: 1, 40: 1, 41: 1, 42: 1, 44: 1, 45: 1,
+	46: 1, 47: 1, 48: 1, 49: 1, 50: 0, 51: 2, 52: 2, 53: 1, 54: 2, 55: 1,
+	56: 1, 57: 1, 58: 1, 59: 1, 60: 2, 61: 1, 62: 1, 63: 1, 64: 1, 65: 1,
+	66: 0, 67: 1, 68: 1, 69: 1, 70: 1, 72: 0, 73: 0, 74: 0, 75: 1, 76: 1,
+	77: 1, 78: 0, 79: 0, 80: 1, 81: 1, 82: 0, 83: 1, 84: 1, 85: 1, 86: 0,
+	87: 0, 88: 1, 89: 1, 90: 0, 92: 1, 98: 0, 100: 0, 101: 0, 102: 1, 103: 0,
+	108: 1, 109: 0, 110: 0, 120: 0, 121: 0, 122: 0, 123: 0, 124: 0, 125: 0, 126: 0,
+	127: 0, 128: 0, 129: 0, 130: 0, 131: 0, 132: 0, 133: 0, 134: 0, 138: 0, 139: 0,
+	140: 1, 141: 0, 142: 1, 143: 0, 144: 0, 145: 0,
+}
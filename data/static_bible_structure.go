@@ -1,77 +1,72 @@
+// Code generated by genstructure from bible_structure.json. DO NOT EDIT.
+
 package data
 
 var BibleStructure = map[int]BookData{
-	1:  {Name: "Genesis", SingularName: "Genesis", Abbreviations: []string{"gen", "ge", "gn"}, ChapterStructure: map[int]int{33: 20, 35: 29, 45: 28, 8: 22, 11: 32, 15: 21, 24: 67, 27: 46, 28: 22, 37: 36, 40: 23, 4: 26, 14: 24, 16: 16, 29: 35, 32: 32, 47: 31, 12: 20, 22: 24, 36: 43, 50: 26, 1: 31, 7: 24, 13: 18, 20: 18, 21: 34, 31: 55, 41: 57, 2: 25, 19: 38, 42: 38, 43: 34, 46: 34, 48: 22, 3: 24, 6: 22, 17: 27, 18: 33, 38: 30, 39: 23, 5: 32, 9: 29, 10: 32, 25: 34, 34: 31, 44: 34, 49: 33, 23: 20, 26: 35, 30: 43}},
-	2:  {Name: "Exodus", SingularName: "Exodus", Abbreviations: []string{"exod", "ex", "exo"}, ChapterStructure: map[int]int{2: 25, 5: 23, 15: 27, 20: 26, 34: 35, 39: 43, 1: 22, 8: 32, 9: 35, 11: 10, 19: 25, 22: 31, 23: 33, 35: 35, 40: 38, 4: 31, 14: 31, 25: 40, 28: 43, 24: 18, 26: 37, 13: 22, 16: 36, 17: 16, 21: 36, 36: 38, 6: 30, 7: 25, 29: 46, 30: 38, 31: 18, 32: 35, 33: 23, 3: 22, 10: 29, 12: 51, 18: 27, 27: 21, 37: 29, 38: 31}},
-	3:  {Name: "Leviticus", SingularName: "Leviticus", Abbreviations: []string{"lev", "le", "lv"}, ChapterStructure: map[int]int{23: 44, 24: 23, 7: 38, 10: 20, 12: 8, 13: 59, 16: 34, 22: 33, 25: 55, 27: 34, 4: 35, 8: 36, 15: 33, 17: 16, 21: 24, 26: 46, 1: 17, 2: 16, 5: 19, 6: 30, 11: 47, 18: 30, 19: 37, 20: 27, 3: 17, 9: 24, 14: 57}},
-	4:  {Name: "Numbers", SingularName: "Numbers", Abbreviations: []string{"num", "nu", "nm", "nb"}, ChapterStructure: map[int]int{8: 26, 27: 23, 31: 54, 5: 31, 17: 13, 16: 50, 25: 18, 2: 34, 12: 16, 21: 35, 30: 16, 32: 42, 33: 56, 35: 34, 1: 54, 7: 89, 11: 35, 13: 33, 14: 45, 22: 41, 28: 31, 19: 22, 26: 65, 34: 29, 4: 49, 9: 23, 10: 36, 15: 41, 29: 40, 6: 27, 18: 32, 20: 29, 23: 30, 24: 25, 36: 13, 3: 51}},
-	5:  {Name: "Deuteronomy", SingularName: "Deuteronomy", Abbreviations: []string{"deut", "de", "dt"}, ChapterStructure: map[int]int{29: 29, 8: 20, 11: 32, 15: 23, 19: 21, 22: 30, 32: 52, 33: 29, 14: 29, 27: 26, 30: 20, 9: 29, 12: 32, 18: 22, 21: 23, 23: 25, 31: 30, 3: 29, 4: 49, 5: 33, 17: 20, 34: 12, 2: 37, 10: 22, 13: 18, 16: 22, 20: 20, 1: 46, 6: 25, 24: 22, 25: 19, 28: 68, 7: 26, 26: 19}},
-	6:  {Name: "Joshua", SingularName: "Joshua", Abbreviations: []string{"josh", "jos", "jsh"}, ChapterStructure: map[int]int{15: 63, 22: 34, 7: 26, 11: 23, 12: 24, 13: 33, 16: 10, 21: 45, 10: 43, 17: 18, 18: 28, 20: 9, 23: 16, 24: 33, 2: 24, 3: 17, 5: 15, 6: 27, 8: 35, 9: 27, 19: 51, 1: 18, 4: 24, 14: 15}},
-	7:  {Name: "Judges", SingularName: "Judges", Abbreviations: []string{"judg", "jdg", "jg", "jdgs"}, ChapterStructure: map[int]int{6: 40, 7: 25, 11: 40, 12: 15, 13: 25, 16: 31, 20: 48, 2: 23, 4: 24, 18: 31, 19: 30, 9: 57, 10: 18, 17: 13, 21: 25, 3: 31, 5: 31, 8: 35, 14: 20, 15: 20, 1: 36}},
-	8:  {Name: "Ruth", SingularName: "Ruth", Abbreviations: []string{"rth", "ru"}, ChapterStructure: map[int]int{2: 23, 3: 18, 4: 22, 1: 22}},
-	9:  {Name: "1 Samuel", SingularName: "1 Samuel", Abbreviations: []string{"1 sam", "i sam", "1st sam", "first sam", "i samuel", "1st samuel", "first samuel", "1 sm", "i sm", "1 sa", "i sa", "1 s"}, ChapterStructure: map[int]int{18: 30, 21: 15, 28: 25, 10: 27, 16: 23, 26: 25, 2: 36, 8: 22, 17: 58, 25: 44, 23: 29, 27: 12, 6: 21, 12: 25, 15: 35, 14: 52, 31: 13, 1: 28, 20: 42, 30: 31, 7: 17, 11: 15, 19: 24, 24: 22, 3: 21, 4: 22, 5: 12, 9: 27, 22: 23, 29: 11, 13: 23}},
-	10: {Name: "2 Samuel", SingularName: "2 Samuel", Abbreviations: []string{"2 sam", "ii sam", "2nd sam", "ii samuel", "second sam", "2nd samuel", "second samuel", "2 sm", "ii sm", "2 sa", "ii sa", "2 s"}, ChapterStructure: map[int]int{1: 27, 6: 23, 10: 19, 13: 39, 16: 23, 23: 39, 2: 32, 8: 18, 11: 27, 14: 33, 17: 29, 19: 43, 21: 22, 4: 12, 5: 25, 7: 29, 9: 13, 12: 31, 15: 37, 24: 25, 3: 39, 18: 33, 20: 26, 22: 51}},
-	11: {Name: "1 Kings", SingularName: "1 Kings", Abbreviations: []string{"1 kgs", "i kgs", "1st kgs", "i kings", "first kgs", "1st kings", "first kings", "1 kin", "i kin", "1 ki", "i ki", "1 k"}, ChapterStructure: map[int]int{3: 28, 10: 29, 16: 34, 18: 46, 5: 18, 12: 33, 13: 34, 20: 43, 22: 53, 4: 34, 8: 66, 15: 34, 17: 24, 19: 21, 6: 38, 7: 51, 9: 28, 11: 43, 14: 31, 21: 29, 1: 53, 2: 46}},
-	12: {Name: "2 Kings", SingularName: "2 Kings", Abbreviations: []string{"2 kgs", "ii kgs", "2nd kgs", "ii kings", "second kgs", "2nd kings", "second kings", "2 kin", "ii kin", "2 ki", "ii ki", "2 k"}, ChapterStructure: map[int]int{5: 27, 8: 29, 9: 37, 14: 29, 15: 38, 17: 41, 20: 21, 1: 18, 6: 33, 7: 20, 18: 37, 19: 37, 21: 26, 22: 20, 24: 20, 11: 21, 12: 21, 16: 20, 23: 37, 2: 25, 3: 27, 10: 36, 13: 25, 25: 30, 4: 44}},
-	13: {Name: "1 Chronicles", SingularName: "1 Chronicles", Abbreviations: []string{"1 chr", "i chr", "1st chr", "1 chron", "i chron", "1st chron", "first chron", "i chronicles", "1st chronicles", "first chronicles", "1 ch", "i ch"}, ChapterStructure: map[int]int{3: 24, 10: 14, 17: 27, 28: 21, 29: 30, 19: 19, 25: 31, 27: 34, 9: 44, 13: 14, 23: 32, 24: 31, 6: 81, 7: 40, 15: 29, 4: 43, 22: 19, 5: 26, 12: 40, 21: 30, 2: 55, 8: 40, 11: 47, 18: 17, 26: 32, 1: 54, 16: 43, 20: 8, 14: 17}},
-	14: {Name: "2 Chronicles", SingularName: "2 Chronicles", Abbreviations: []string{"2 chr", "ii chr", "2nd chr", "2 chron", "ii chron", "2nd chron", "second chron", "ii chronicles", "2nd chronicles", "second chronicles", "2 ch", "ii ch"}, ChapterStructure: map[int]int{3: 17, 5: 14, 10: 19, 23: 21, 24: 27, 32: 33, 35: 27, 8: 18, 12: 16, 17: 19, 18: 34, 19: 11, 30: 27, 2: 18, 6: 42, 7: 22, 9: 31, 14: 15, 15: 19, 22: 12, 29: 36, 34: 33, 25: 28, 16: 14, 28: 27, 36: 23, 26: 23, 27: 9, 13: 22, 4: 22, 11: 23, 20: 37, 21: 20, 31: 21, 1: 17, 33: 25}},
-	15: {Name: "Ezra", SingularName: "Ezra", Abbreviations: []string{"ezr", "ez"}, ChapterStructure: map[int]int{1: 11, 2: 70, 3: 13, 5: 17, 6: 22, 7: 28, 8: 36, 4: 24, 9: 15, 10: 44}},
-	16: {Name: "Nehemiah", SingularName: "Nehemiah", Abbreviations: []string{"neh", "ne"}, ChapterStructure: map[int]int{1: 11, 4: 23, 5: 19, 6: 19, 7: 73, 8: 18, 10: 39, 2: 20, 3: 32, 9: 38, 11: 36, 12: 47, 13: 31}},
-	17: {Name: "Esther", SingularName: "Esther", Abbreviations: []string{"esth", "est", "esth 1", "es"}, ChapterStructure: map[int]int{2: 23, 4: 17, 6: 14, 8: 17, 9: 32, 1: 22, 3: 15, 5: 14, 7: 10, 10: 3}},
-	18: {Name: "Job", SingularName: "Job", Abbreviations: []string{"jb"}, ChapterStructure: map[int]int{10: 22, 14: 22, 28: 28, 29: 25, 33: 33, 34: 37, 39: 30, 41: 34, 1: 22, 7: 21, 11: 20, 26: 14, 30: 31, 38: 41, 40: 24, 4: 21, 5: 27, 17: 16, 19: 29, 20: 29, 2: 13, 18: 21, 24: 25, 35: 16, 36: 33, 8: 22, 9: 35, 13: 28, 16: 22, 21: 34, 22: 30, 31: 40, 3: 26, 12: 25, 27: 23, 32: 22, 6: 30, 15: 35, 25: 6, 23: 17, 37: 24, 42: 17}},
-	19: {Name: "Psalms", SingularName: "Psalm", Abbreviations: []string{"ps", "pss", "psalm", "pslm", "psa", "psm"}, ChapterStructure: map[int]int{39: 13, 120: 7, 130: 8, 26: 12, 87: 7, 148: 14, 77: 20, 91: 16, 128: 6, 2: 12, 36: 12, 95: 11, 119: 176, 28: 9, 81: 16, 104: 35, 118: 29, 123: 4, 149: 9, 29: 11, 31: 24, 43: 5, 62: 12, 73: 28, 126: 6, 136: 26, 4: 8, 24: 10, 72: 20, 141: 10, 1: 6, 37: 40, 61: 8, 92: 15, 122: 9, 17: 15, 50: 23, 124: 8, 132: 18, 134: 3, 8: 9, 15: 5, 41: 13, 69: 36, 94: 23, 131: 3, 150: 6, 70: 5, 100: 5, 105: 45, 23: 6, 42: 11, 52: 9, 63: 11, 80: 19, 90: 17, 97: 12, 101: 8, 135: 21, 139: 24, 12: 8, 49: 20, 99: 9, 125: 5, 30: 12, 32: 11, 86: 17, 106: 48, 143: 12, 6: 10, 13: 6, 51: 19, 53: 6, 20: 9, 22: 31, 33: 22, 38: 22, 58: 11, 75: 10, 84: 12, 19: 14, 25: 22, 83: 18, 145: 21, 40: 17, 47: 9, 67: 7, 60: 12, 74: 23, 109: 31, 121: 8, 18: 50, 65: 13, 66: 20, 116: 19, 127: 5, 140: 13, 5: 12, 14: 7, 55: 23, 111: 10, 142: 7, 79: 13, 108: 13, 110: 7, 137: 9, 146: 10, 21: 13, 46: 11, 113: 9, 48: 14, 57: 11, 102: 28, 147: 20, 107: 43, 68: 35, 88: 18, 89: 52, 34: 22, 44: 26, 59: 17, 71: 24, 76: 12, 85: 13, 98: 9, 129: 8, 144: 15, 3: 8, 27: 14, 35: 28, 78: 72, 96: 13, 114: 8, 115: 18, 9: 20, 11: 7, 45: 17, 56: 13, 93: 5, 103: 22, 112: 10, 64: 10, 133: 3, 138: 8, 7: 17, 10: 18, 54: 7, 82: 8, 117: 2, 16: 11}},
-	20: {Name: "Proverbs", SingularName: "Proverbs", Abbreviations: []string{"prov", "pro", "prv", "pr"}, ChapterStructure: map[int]int{13: 25, 5: 23, 8: 36, 19: 29, 22: 29, 24: 34, 28: 28, 10: 32, 11: 31, 16: 33, 27: 27, 30: 33, 31: 31, 17: 28, 20: 30, 21: 31, 23: 35, 2: 22, 29: 27, 9: 18, 12: 28, 25: 28, 1: 33, 3: 35, 4: 27, 6: 35, 7: 27, 15: 33, 26: 28, 14: 35, 18: 24}},
-	21: {Name: "Ecclesiastes", SingularName: "Ecclesiastes", Abbreviations: []string{"eccl", "ecc", "ecc1", "eccles", "eccle", "ec"}, ChapterStructure: map[int]int{12: 14, 2: 26, 3: 22, 5: 20, 7: 29, 9: 18, 10: 20, 11: 10, 1: 18, 4: 16, 6: 12, 8: 17}},
-	22: {Name: "Song of Solomon", SingularName: "Song of Solomon", Abbreviations: []string{"song", "song of songs", "the song of songs", "song of sol", "the song of solomon", "so", "sos"}, ChapterStructure: map[int]int{1: 17, 2: 17, 3: 11, 4: 16, 5: 16, 6: 13, 7: 13, 8: 14}},
-	23: {Name: "Isaiah", SingularName: "Isaiah", Abbreviations: []string{"isiah", "isa", "is"}, ChapterStructure: map[int]int{37: 38, 41: 29, 44: 28, 65: 25, 11: 16, 45: 25, 59: 21, 61: 11, 66: 24, 13: 22, 1: 31, 6: 13, 39: 8, 42: 25, 63: 19, 14: 32, 24: 23, 25: 12, 54: 17, 22: 25, 3: 26, 31: 9, 48: 22, 50: 11, 56: 12, 4: 6, 9: 21, 47: 15, 52: 15, 64: 12, 33: 24, 38: 22, 55: 13, 53: 12, 12: 6, 15: 9, 40: 31, 8: 22, 16: 14, 57: 21, 28: 29, 7: 25, 43: 28, 2: 22, 23: 18, 35: 10, 46: 13, 18: 7, 21: 17, 32: 20, 36: 22, 26: 21, 60: 22, 5: 30, 10: 34, 17: 14, 19: 25, 20: 6, 34: 17, 58: 14, 27: 13, 49: 26, 51: 23, 62: 12, 29: 24, 30: 33}},
-	24: {Name: "Jeremiah", SingularName: "Jeremiah", Abbreviations: []string{"jer", "je", "jr"}, ChapterStructure: map[int]int{46: 28, 11: 23, 12: 17, 17: 27, 19: 15, 20: 18, 27: 22, 32: 44, 33: 26, 2: 37, 3: 25, 9: 26, 23: 40, 35: 19, 37: 21, 39: 18, 40: 16, 14: 22, 16: 21, 22: 30, 31: 40, 38: 28, 43: 13, 44: 30, 47: 7, 10: 25, 13: 27, 1: 19, 4: 31, 42: 22, 48: 47, 21: 14, 24: 10, 30: 24, 45: 5, 50: 46, 52: 34, 5: 31, 25: 38, 26: 24, 29: 32, 7: 34, 18: 23, 34: 22, 36: 32, 49: 39, 51: 64, 6: 30, 8: 22, 15: 21, 28: 17, 41: 18}},
-	25: {Name: "Lamentations", SingularName: "Lamentations", Abbreviations: []string{"lam", "la"}, ChapterStructure: map[int]int{2: 22, 3: 66, 4: 22, 5: 22, 1: 22}},
-	26: {Name: "Ezekiel", SingularName: "Ezekiel", Abbreviations: []string{"ezek", "eze"}, ChapterStructure: map[int]int{16: 63, 30: 26, 32: 32, 41: 26, 4: 17, 7: 27, 15: 8, 27: 36, 34: 31, 39: 29, 44: 31, 12: 28, 18: 32, 24: 27, 29: 21, 33: 33, 35: 15, 45: 25, 1: 28, 3: 27, 10: 22, 13: 23, 17: 24, 20: 49, 21: 32, 36: 38, 2: 10, 5: 17, 6: 14, 14: 23, 25: 17, 26: 21, 37: 28, 40: 49, 19: 14, 31: 18, 38: 23, 43: 27, 47: 23, 8: 18, 11: 25, 23: 49, 28: 26, 46: 24, 9: 11, 22: 31, 42: 20, 48: 35}},
-	27: {Name: "Daniel", SingularName: "Daniel", Abbreviations: []string{"dan", "da", "dn"}, ChapterStructure: map[int]int{9: 27, 12: 13, 1: 21, 3: 30, 4: 37, 10: 21, 11: 45, 2: 49, 5: 31, 6: 28, 7: 28, 8: 27}},
-	28: {Name: "Hosea", SingularName: "Hosea", Abbreviations: []string{"hos", "ho"}, ChapterStructure: map[int]int{14: 9, 1: 11, 4: 19, 6: 11, 7: 16, 10: 15, 11: 12, 12: 14, 13: 16, 2: 23, 3: 5, 5: 15, 8: 14, 9: 17}},
-	29: {Name: "Joel", SingularName: "Joel", Abbreviations: []string{"jl", "joe"}, ChapterStructure: map[int]int{3: 21, 1: 20, 2: 32}},
-	30: {Name: "Amos", SingularName: "Amos", Abbreviations: []string{"am"}, ChapterStructure: map[int]int{2: 16, 5: 27, 6: 14, 8: 14, 9: 15, 1: 15, 3: 15, 4: 13, 7: 17}},
-	31: {Name: "Obadiah", SingularName: "Obadiah", Abbreviations: []string{"obad", "oba", "ob"}, ChapterStructure: map[int]int{1: 21}},
-	32: {Name: "Jonah", SingularName: "Jonah", Abbreviations: []string{"jon", "jnh"}, ChapterStructure: map[int]int{2: 10, 3: 10, 4: 11, 1: 17}},
-	33: {Name: "Micah", SingularName: "Micah", Abbreviations: []string{"mic", "mc"}, ChapterStructure: map[int]int{1: 16, 2: 13, 3: 12, 4: 13, 5: 15, 6: 16, 7: 20}},
-	34: {Name: "Nahum", SingularName: "Nahum", Abbreviations: []string{"nah", "na"}, ChapterStructure: map[int]int{1: 15, 2: 13, 3: 19}},
-	35: {Name: "Habakkuk", SingularName: "Habakkuk", Abbreviations: []string{"hab", "hb"}, ChapterStructure: map[int]int{1: 17, 2: 20, 3: 19}},
-	36: {Name: "Zephaniah", SingularName: "Zephaniah", Abbreviations: []string{"zeph", "zep", "zp"}, ChapterStructure: map[int]int{2: 15, 3: 20, 1: 18}},
-	37: {Name: "Haggai", SingularName: "Haggai", Abbreviations: []string{"hag", "hg"}, ChapterStructure: map[int]int{1: 15, 2: 23}},
-	38: {Name: "Zechariah", SingularName: "Zechariah", Abbreviations: []string{"zech", "zec", "zc"}, ChapterStructure: map[int]int{1: 21, 2: 13, 3: 10, 5: 11, 6: 15, 7: 14, 8: 23, 12: 14, 4: 14, 9: 17, 10: 12, 11: 17, 13: 9, 14: 21}},
-	39: {Name: "Malachi", SingularName: "Malachi", Abbreviations: []string{"mal", "ml"}, ChapterStructure: map[int]int{1: 14, 2: 17, 3: 18, 4: 6}},
-	40: {Name: "Matthew", SingularName: "Matthew", Abbreviations: []string{"matt", "mt"}, ChapterStructure: map[int]int{10: 42, 11: 30, 12: 50, 14: 36, 18: 35, 19: 30, 22: 46, 26: 75, 1: 25, 2: 23, 3: 17, 6: 34, 9: 38, 15: 39, 28: 20, 4: 25, 20: 34, 24: 51, 25: 46, 27: 66, 5: 48, 7: 29, 8: 34, 13: 58, 16: 28, 17: 27, 21: 46, 23: 39}},
-	41: {Name: "Mark", SingularName: "Mark", Abbreviations: []string{"mk", "mar", "mr"}, ChapterStructure: map[int]int{3: 35, 4: 41, 15: 47, 14: 72, 1: 45, 8: 38, 11: 33, 12: 44, 16: 20, 9: 50, 10: 52, 5: 43, 6: 56, 7: 37, 13: 37, 2: 28}},
-	42: {Name: "Luke", SingularName: "Luke", Abbreviations: []string{"lk"}, ChapterStructure: map[int]int{23: 56, 24: 53, 2: 52, 4: 44, 8: 56, 17: 37, 19: 48, 20: 47, 13: 35, 16: 31, 21: 38, 12: 59, 14: 35, 1: 80, 3: 38, 5: 39, 6: 49, 10: 42, 18: 43, 7: 50, 9: 62, 11: 54, 15: 32, 22: 71}},
-	43: {Name: "John", SingularName: "John", Abbreviations: []string{"jn", "jhn", "joh"}, ChapterStructure: map[int]int{2: 25, 8: 59, 18: 40, 3: 36, 11: 57, 15: 27, 17: 26, 19: 42, 21: 25, 14: 31, 1: 51, 4: 54, 7: 53, 10: 42, 12: 50, 13: 38, 16: 33, 5: 47, 6: 71, 9: 41, 20: 31}},
-	44: {Name: "Acts", SingularName: "Acts", Abbreviations: []string{"act", "acts of the apostles", "ac"}, ChapterStructure: map[int]int{3: 26, 4: 37, 8: 40, 10: 48, 15: 41, 20: 38, 22: 30, 25: 27, 2: 47, 5: 42, 9: 43, 16: 40, 17: 34, 18: 28, 24: 27, 28: 31, 1: 26, 6: 15, 11: 30, 12: 25, 23: 35, 26: 32, 27: 44, 7: 60, 13: 52, 14: 28, 19: 41, 21: 40}},
-	45: {Name: "Romans", SingularName: "Romans", Abbreviations: []string{"rom", "ro", "rm"}, ChapterStructure: map[int]int{8: 39, 9: 33, 11: 36, 5: 21, 6: 23, 14: 23, 1: 32, 3: 31, 7: 25, 10: 21, 12: 21, 16: 27, 2: 29, 4: 25, 13: 14, 15: 33}},
-	46: {Name: "1 Corinthians", SingularName: "1 Corinthians", Abbreviations: []string{"1 cor", "i cor", "1st cor", "first cor", "i corinthians", "1st corinthians", "first corinthians", "1 co", "i co", "1st co", "first co"}, ChapterStructure: map[int]int{3: 23, 4: 21, 7: 40, 8: 13, 1: 31, 2: 16, 5: 13, 6: 20, 11: 34, 12: 31, 15: 58, 10: 33, 13: 13, 14: 40, 9: 27, 16: 24}},
-	47: {Name: "2 Corinthians", SingularName: "2 Corinthians", Abbreviations: []string{"2 cor", "ii cor", "2nd cor", "second cor", "ii corinthians", "2nd corinthians", "second corinthians", "2 co", "ii co", "2nd co", "second co"}, ChapterStructure: map[int]int{7: 16, 10: 18, 13: 14, 1: 24, 3: 18, 4: 18, 6: 18, 8: 24, 9: 15, 11: 33, 12: 21, 2: 17, 5: 21}},
-	48: {Name: "Galatians", SingularName: "Galatians", Abbreviations: []string{"gal", "ga"}, ChapterStructure: map[int]int{2: 21, 3: 29, 4: 31, 5: 26, 6: 18, 1: 24}},
-	49: {Name: "Ephesians", SingularName: "Ephesians", Abbreviations: []string{"eph", "ephes"}, ChapterStructure: map[int]int{3: 21, 4: 32, 5: 33, 6: 24, 1: 23, 2: 22}},
-	50: {Name: "Philippians", SingularName: "Philippians", Abbreviations: []string{"phil", "pp"}, ChapterStructure: map[int]int{1: 30, 2: 30, 3: 21, 4: 23}},
-	51: {Name: "Colossians", SingularName: "Colossians", Abbreviations: []string{"col", "co"}, ChapterStructure: map[int]int{1: 29, 2: 23, 3: 25, 4: 18}},
-	52: {Name: "1 Thessalonians", SingularName: "1 Thessalonians", Abbreviations: []string{"i thess", "1 thess", "1st thess", "first thess", "i thes", "1 thes", "1st thes", "first thes", "i thessalonians", "1st thessalonians", "first thessalonians", "i th", "1 th", "1st th", "first th"}, ChapterStructure: map[int]int{2: 20, 3: 13, 4: 18, 5: 28, 1: 10}},
-	53: {Name: "2 Thessalonians", SingularName: "2 Thessalonians", Abbreviations: []string{"ii thess", "2 thess", "2nd thess", "second thess", "ii thes", "2 thes", "2nd thes", "second thes", "ii thessalonians", "2nd thessalonians", "second thessalonians", "ii th", "2 th", "2nd th", "second th"}, ChapterStructure: map[int]int{1: 12, 2: 17, 3: 18}},
-	54: {Name: "1 Timothy", SingularName: "1 Timothy", Abbreviations: []string{"1 tim", "i tim", "1st tim", "first tim", "i timothy", "1st timothy", "first timothy", "1 ti", "i ti", "1st ti", "first ti"}, ChapterStructure: map[int]int{5: 25, 6: 21, 1: 20, 2: 15, 3: 16, 4: 16}},
-	55: {Name: "2 Timothy", SingularName: "2 Timothy", Abbreviations: []string{"2 tim", "ii tim", "2nd tim", "second tim", "ii timothy", "2nd timothy", "second timothy", "2 ti", "ii ti", "2nd ti", "second ti"}, ChapterStructure: map[int]int{1: 18, 2: 26, 3: 17, 4: 22}},
-	56: {Name: "Titus", SingularName: "Titus", Abbreviations: []string{"ti"}, ChapterStructure: map[int]int{1: 16, 2: 15, 3: 15}},
-	57: {Name: "Philemon", SingularName: "Philemon", Abbreviations: []string{"phlm", "philem", "pm"}, ChapterStructure: map[int]int{1: 25}},
-	58: {Name: "Hebrews", SingularName: "Hebrews", Abbreviations: []string{"heb"}, ChapterStructure: map[int]int{7: 28, 12: 29, 13: 25, 4: 16, 5: 14, 8: 13, 9: 28, 10: 39, 11: 40, 1: 14, 2: 18, 3: 19, 6: 20}},
-	59: {Name: "James", SingularName: "James", Abbreviations: []string{"jas", "jam", "jm"}, ChapterStructure: map[int]int{1: 27, 2: 26, 3: 18, 4: 17, 5: 20}},
-	60: {Name: "1 Peter", SingularName: "1 Peter", Abbreviations: []string{"i pet", "1 pet", "1st pet", "first pet", "i peter", "1st peter", "first peter", "i pet", "1 pet", "1st pet", "first pet", "i pe", "1 pe", "1st pe", "first pe", "i pt", "1 pt", "1st pt", "first pt", "i p", "1 p", "1st p", "first p"}, ChapterStructure: map[int]int{5: 14, 1: 25, 2: 25, 3: 22, 4: 19}},
-	61: {Name: "2 Peter", SingularName: "2 Peter", Abbreviations: []string{"ii pet", "2 pet", "2nd pet", "second pet", "ii peter", "2nd peter", "second peter", "ii pet", "2 pet", "2nd pet", "second pet", "ii pe", "2 pe", "2nd pe", "second pe", "ii pt", "2 pt", "2nd pt", "second pt", "i p", "2 p", "2nd p", "first p"}, ChapterStructure: map[int]int{2: 22, 3: 18, 1: 21}},
-	62: {Name: "1 John", SingularName: "1 John", Abbreviations: []string{"i john", "1st john", "first john", "1 jn", "i jn", "1st jn", "first jn", "1 jo", "i jo", "1st jo", "first jo", "1 joh", "i joh", "1st joh", "first joh", "1 jhn", "i jhn", "1st jhn", "first jhn", "1 j", "i j", "1st j", "first j"}, ChapterStructure: map[int]int{1: 10, 2: 29, 3: 24, 4: 21, 5: 21}},
-	63: {Name: "2 John", SingularName: "2 John", Abbreviations: []string{"ii john", "2nd john", "second john", "2 jn", "ii jn", "2nd jn", "second jn", "2 jo", "ii jo", "2nd jo", "second jo", "2 joh", "ii joh", "2nd joh", "second joh", "2 jhn", "ii jhn", "2nd jhn", "second jhn", "2 j", "ii j", "2nd j", "second j"}, ChapterStructure: map[int]int{1: 13}},
-	64: {Name: "3 John", SingularName: "3 John", Abbreviations: []string{"iii john", "3rd john", "third john", "3 jn", "iii jn", "3rd jn", "third jn", "3 jo", "iii jo", "3rd jo", "third jo", "3 joh", "iii joh", "3rd joh", "third joh", "3 jhn", "iii jhn", "3rd jhn", "third jhn", "3 j", "iii j", "3rd j", "third j"}, ChapterStructure: map[int]int{1: 15}},
-	65: {Name: "Jude", SingularName: "Jude", Abbreviations: []string{"jd"}, ChapterStructure: map[int]int{1: 25}},
-	66: {Name: "Revelation", SingularName: "Revelation", Abbreviations: []string{"rev", "re", "the revelation"}, ChapterStructure: map[int]int{13: 18, 16: 21, 3: 22, 5: 14, 8: 13, 14: 20, 17: 18, 19: 21, 22: 21, 15: 8, 4: 11, 6: 17, 10: 11, 12: 17, 18: 24, 20: 15, 21: 27, 1: 20, 2: 29, 7: 17, 9: 21, 11: 19}},
-}
-
-type BookData struct {
-	Name             string
-	SingularName     string
-	Abbreviations    []string
-	ChapterStructure map[int]int
+	1:  {Name: "Genesis", SingularName: "Genesis", Abbreviations: []string{"ge", "gen", "gn"}, Chapters: []int{31, 25, 24, 26, 32, 22, 24, 22, 29, 32, 32, 20, 18, 24, 21, 16, 27, 33, 38, 18, 34, 24, 20, 67, 34, 35, 46, 22, 35, 43, 55, 32, 20, 31, 29, 43, 36, 30, 23, 23, 57, 38, 34, 34, 28, 34, 31, 22, 33, 26}},
+	2:  {Name: "Exodus", SingularName: "Exodus", Abbreviations: []string{"ex", "exo", "exod"}, Chapters: []int{22, 25, 22, 31, 23, 30, 25, 32, 35, 29, 10, 51, 22, 31, 27, 36, 16, 27, 25, 26, 36, 31, 33, 18, 40, 37, 21, 43, 46, 38, 18, 35, 23, 35, 35, 38, 29, 31, 43, 38}},
+	3:  {Name: "Leviticus", SingularName: "Leviticus", Abbreviations: []string{"le", "lev", "lv"}, Chapters: []int{17, 16, 17, 35, 19, 30, 38, 36, 24, 20, 47, 8, 59, 57, 33, 34, 16, 30, 37, 27, 24, 33, 44, 23, 55, 46, 34}},
+	4:  {Name: "Numbers", SingularName: "Numbers", Abbreviations: []string{"nb", "nm", "nu", "num"}, Chapters: []int{54, 34, 51, 49, 31, 27, 89, 26, 23, 36, 35, 16, 33, 45, 41, 50, 13, 32, 22, 29, 35, 41, 30, 25, 18, 65, 23, 31, 40, 16, 54, 42, 56, 29, 34, 13}},
+	5:  {Name: "Deuteronomy", SingularName: "Deuteronomy", Abbreviations: []string{"de", "deut", "dt"}, Chapters: []int{46, 37, 29, 49, 33, 25, 26, 20, 29, 22, 32, 32, 18, 29, 23, 22, 20, 22, 21, 20, 23, 30, 25, 22, 19, 19, 26, 68, 29, 20, 30, 52, 29, 12}},
+	6:  {Name: "Joshua", SingularName: "Joshua", Abbreviations: []string{"jos", "josh", "jsh"}, Chapters: []int{18, 24, 17, 24, 15, 27, 26, 35, 27, 43, 23, 24, 33, 15, 63, 10, 18, 28, 51, 9, 45, 34, 16, 33}},
+	7:  {Name: "Judges", SingularName: "Judges", Abbreviations: []string{"jdg", "jdgs", "jg", "judg"}, Chapters: []int{36, 23, 31, 24, 31, 40, 25, 35, 57, 18, 40, 15, 25, 20, 20, 31, 13, 31, 30, 48, 25}},
+	8:  {Name: "Ruth", SingularName: "Ruth", Abbreviations: []string{"rth", "ru"}, Chapters: []int{22, 23, 18, 22}},
+	9:  {Name: "1 Samuel", SingularName: "1 Samuel", Abbreviations: []string{"1 s", "1 sa", "1 sam", "1 sm", "1st sam", "1st samuel", "first sam", "first samuel", "i sa", "i sam", "i samuel", "i sm"}, Chapters: []int{28, 36, 21, 22, 12, 21, 17, 22, 27, 27, 15, 25, 23, 52, 35, 23, 58, 30, 24, 42, 15, 23, 29, 22, 44, 25, 12, 25, 11, 31, 13}},
+	10: {Name: "2 Samuel", SingularName: "2 Samuel", Abbreviations: []string{"2 s", "2 sa", "2 sam", "2 sm", "2nd sam", "2nd samuel", "ii sa", "ii sam", "ii samuel", "ii sm", "second sam", "second samuel"}, Chapters: []int{27, 32, 39, 12, 25, 23, 29, 18, 13, 19, 27, 31, 39, 33, 37, 23, 29, 33, 43, 26, 22, 51, 39, 25}},
+	11: {Name: "1 Kings", SingularName: "1 Kings", Abbreviations: []string{"1 k", "1 kgs", "1 ki", "1 kin", "1st kgs", "1st kings", "first kgs", "first kings", "i kgs", "i ki", "i kin", "i kings"}, Chapters: []int{53, 46, 28, 34, 18, 38, 51, 66, 28, 29, 43, 33, 34, 31, 34, 34, 24, 46, 21, 43, 29, 53}},
+	12: {Name: "2 Kings", SingularName: "2 Kings", Abbreviations: []string{"2 k", "2 kgs", "2 ki", "2 kin", "2nd kgs", "2nd kings", "ii kgs", "ii ki", "ii kin", "ii kings", "second kgs", "second kings"}, Chapters: []int{18, 25, 27, 44, 27, 33, 20, 29, 37, 36, 21, 21, 25, 29, 38, 20, 41, 37, 37, 21, 26, 20, 37, 20, 30}},
+	13: {Name: "1 Chronicles", SingularName: "1 Chronicles", Abbreviations: []string{"1 ch", "1 chr", "1 chron", "1st chr", "1st chron", "1st chronicles", "first chron", "first chronicles", "i ch", "i chr", "i chron", "i chronicles"}, Chapters: []int{54, 55, 24, 43, 26, 81, 40, 40, 44, 14, 47, 40, 14, 17, 29, 43, 27, 17, 19, 8, 30, 19, 32, 31, 31, 32, 34, 21, 30}},
+	14: {Name: "2 Chronicles", SingularName: "2 Chronicles", Abbreviations: []string{"2 ch", "2 chr", "2 chron", "2nd chr", "2nd chron", "2nd chronicles", "ii ch", "ii chr", "ii chron", "ii chronicles", "second chron", "second chronicles"}, Chapters: []int{17, 18, 17, 22, 14, 42, 22, 18, 31, 19, 23, 16, 22, 15, 19, 14, 19, 34, 11, 37, 20, 12, 21, 27, 28, 23, 9, 27, 36, 27, 21, 33, 25, 33, 27, 23}},
+	15: {Name: "Ezra", SingularName: "Ezra", Abbreviations: []string{"ez", "ezr"}, Chapters: []int{11, 70, 13, 24, 17, 22, 28, 36, 15, 44}},
+	16: {Name: "Nehemiah", SingularName: "Nehemiah", Abbreviations: []string{"ne", "neh"}, Chapters: []int{11, 20, 32, 23, 19, 19, 73, 18, 38, 39, 36, 47, 31}},
+	17: {Name: "Esther", SingularName: "Esther", Abbreviations: []string{"es", "est", "esth"}, Chapters: []int{22, 23, 15, 17, 14, 14, 10, 17, 32, 3}},
+	18: {Name: "Job", SingularName: "Job", Abbreviations: []string{"jb"}, Chapters: []int{22, 13, 26, 21, 27, 30, 21, 22, 35, 22, 20, 25, 28, 22, 35, 22, 16, 21, 29, 29, 34, 30, 17, 25, 6, 14, 23, 28, 25, 31, 40, 22, 33, 37, 16, 33, 24, 41, 30, 24, 34, 17}},
+	19: {Name: "Psalms", SingularName: "Psalm", Abbreviations: []string{"ps", "psa", "psalm", "pslm", "psm", "pss"}, Chapters: []int{6, 12, 8, 8, 12, 10, 17, 9, 20, 18, 7, 8, 6, 7, 5, 11, 15, 50, 14, 9, 13, 31, 6, 10, 22, 12, 14, 9, 11, 12, 24, 11, 22, 22, 28, 12, 40, 22, 13, 17, 13, 11, 5, 26, 17, 11, 9, 14, 20, 23, 19, 9, 6, 7, 23, 13, 11, 11, 17, 12, 8, 12, 11, 10, 13, 20, 7, 35, 36, 5, 24, 20, 28, 23, 10, 12, 20, 72, 13, 19, 16, 8, 18, 12, 13, 17, 7, 18, 52, 17, 16, 15, 5, 23, 11, 13, 12, 9, 9, 5, 8, 28, 22, 35, 45, 48, 43, 13, 31, 7, 10, 10, 9, 8, 18, 19, 2, 29, 176, 7, 8, 9, 4, 8, 5, 6, 5, 6, 8, 8, 3, 18, 3, 3, 21, 26, 9, 8, 24, 13, 10, 7, 12, 15, 21, 10, 20, 14, 9, 6}},
+	20: {Name: "Proverbs", SingularName: "Proverbs", Abbreviations: []string{"pr", "pro", "prov", "prv"}, Chapters: []int{33, 22, 35, 27, 23, 35, 27, 36, 18, 32, 31, 28, 25, 35, 33, 33, 28, 24, 29, 30, 31, 29, 35, 34, 28, 28, 27, 28, 27, 33, 31}},
+	21: {Name: "Ecclesiastes", SingularName: "Ecclesiastes", Abbreviations: []string{"ec", "ecc", "eccl", "eccle", "eccles"}, Chapters: []int{18, 26, 22, 16, 20, 12, 29, 17, 18, 20, 10, 14}},
+	22: {Name: "Song of Solomon", SingularName: "Song of Solomon", Abbreviations: []string{"so", "song", "song of sol", "song of songs", "sos", "the song of solomon", "the song of songs"}, Chapters: []int{17, 17, 11, 16, 16, 13, 13, 14}},
+	23: {Name: "Isaiah", SingularName: "Isaiah", Abbreviations: []string{"is", "isa", "isiah"}, Chapters: []int{31, 22, 26, 6, 30, 13, 25, 22, 21, 34, 16, 6, 22, 32, 9, 14, 14, 7, 25, 6, 17, 25, 18, 23, 12, 21, 13, 29, 24, 33, 9, 20, 24, 17, 10, 22, 38, 22, 8, 31, 29, 25, 28, 28, 25, 13, 15, 22, 26, 11, 23, 15, 12, 17, 13, 12, 21, 14, 21, 22, 11, 12, 19, 12, 25, 24}},
+	24: {Name: "Jeremiah", SingularName: "Jeremiah", Abbreviations: []string{"je", "jer", "jr"}, Chapters: []int{19, 37, 25, 31, 31, 30, 34, 22, 26, 25, 23, 17, 27, 22, 21, 21, 27, 23, 15, 18, 14, 30, 40, 10, 38, 24, 22, 17, 32, 24, 40, 44, 26, 22, 19, 32, 21, 28, 18, 16, 18, 22, 13, 30, 5, 28, 7, 47, 39, 46, 64, 34}},
+	25: {Name: "Lamentations", SingularName: "Lamentations", Abbreviations: []string{"la", "lam"}, Chapters: []int{22, 22, 66, 22, 22}},
+	26: {Name: "Ezekiel", SingularName: "Ezekiel", Abbreviations: []string{"eze", "ezek"}, Chapters: []int{28, 10, 27, 17, 17, 14, 27, 18, 11, 22, 25, 28, 23, 23, 8, 63, 24, 32, 14, 49, 32, 31, 49, 27, 17, 21, 36, 26, 21, 26, 18, 32, 33, 31, 15, 38, 28, 23, 29, 49, 26, 20, 27, 31, 25, 24, 23, 35}},
+	27: {Name: "Daniel", SingularName: "Daniel", Abbreviations: []string{"da", "dan", "dn"}, Chapters: []int{21, 49, 30, 37, 31, 28, 28, 27, 27, 21, 45, 13}},
+	28: {Name: "Hosea", SingularName: "Hosea", Abbreviations: []string{"ho", "hos"}, Chapters: []int{11, 23, 5, 19, 15, 11, 16, 14, 17, 15, 12, 14, 16, 9}},
+	29: {Name: "Joel", SingularName: "Joel", Abbreviations: []string{"jl", "joe"}, Chapters: []int{20, 32, 21}},
+	30: {Name: "Amos", SingularName: "Amos", Abbreviations: []string{"am"}, Chapters: []int{15, 16, 15, 13, 27, 14, 17, 14, 15}},
+	31: {Name: "Obadiah", SingularName: "Obadiah", Abbreviations: []string{"ob", "oba", "obad"}, Chapters: []int{21}},
+	32: {Name: "Jonah", SingularName: "Jonah", Abbreviations: []string{"jnh", "jon"}, Chapters: []int{17, 10, 10, 11}},
+	33: {Name: "Micah", SingularName: "Micah", Abbreviations: []string{"mc", "mic"}, Chapters: []int{16, 13, 12, 13, 15, 16, 20}},
+	34: {Name: "Nahum", SingularName: "Nahum", Abbreviations: []string{"na", "nah"}, Chapters: []int{15, 13, 19}},
+	35: {Name: "Habakkuk", SingularName: "Habakkuk", Abbreviations: []string{"hab", "hb"}, Chapters: []int{17, 20, 19}},
+	36: {Name: "Zephaniah", SingularName: "Zephaniah", Abbreviations: []string{"zep", "zeph", "zp"}, Chapters: []int{18, 15, 20}},
+	37: {Name: "Haggai", SingularName: "Haggai", Abbreviations: []string{"hag", "hg"}, Chapters: []int{15, 23}},
+	38: {Name: "Zechariah", SingularName: "Zechariah", Abbreviations: []string{"zc", "zec", "zech"}, Chapters: []int{21, 13, 10, 14, 11, 15, 14, 23, 17, 12, 17, 14, 9, 21}},
+	39: {Name: "Malachi", SingularName: "Malachi", Abbreviations: []string{"mal", "ml"}, Chapters: []int{14, 17, 18, 6}},
+	40: {Name: "Matthew", SingularName: "Matthew", Abbreviations: []string{"matt", "mt"}, Chapters: []int{25, 23, 17, 25, 48, 34, 29, 34, 38, 42, 30, 50, 58, 36, 39, 28, 27, 35, 30, 34, 46, 46, 39, 51, 46, 75, 66, 20}},
+	41: {Name: "Mark", SingularName: "Mark", Abbreviations: []string{"mar", "mk", "mr"}, Chapters: []int{45, 28, 35, 41, 43, 56, 37, 38, 50, 52, 33, 44, 37, 72, 47, 20}},
+	42: {Name: "Luke", SingularName: "Luke", Abbreviations: []string{"lk"}, Chapters: []int{80, 52, 38, 44, 39, 49, 50, 56, 62, 42, 54, 59, 35, 35, 32, 31, 37, 43, 48, 47, 38, 71, 56, 53}},
+	43: {Name: "John", SingularName: "John", Abbreviations: []string{"jhn", "jn", "joh"}, Chapters: []int{51, 25, 36, 54, 47, 71, 53, 59, 41, 42, 57, 50, 38, 31, 27, 33, 26, 40, 42, 31, 25}},
+	44: {Name: "Acts", SingularName: "Acts", Abbreviations: []string{"ac", "act", "acts of the apostles"}, Chapters: []int{26, 47, 26, 37, 42, 15, 60, 40, 43, 48, 30, 25, 52, 28, 41, 40, 34, 28, 41, 38, 40, 30, 35, 27, 27, 32, 44, 31}},
+	45: {Name: "Romans", SingularName: "Romans", Abbreviations: []string{"rm", "ro", "rom"}, Chapters: []int{32, 29, 31, 25, 21, 23, 25, 39, 33, 21, 36, 21, 14, 23, 33, 27}},
+	46: {Name: "1 Corinthians", SingularName: "1 Corinthians", Abbreviations: []string{"1 co", "1 cor", "1st co", "1st cor", "1st corinthians", "first co", "first cor", "first corinthians", "i co", "i cor", "i corinthians"}, Chapters: []int{31, 16, 23, 21, 13, 20, 40, 13, 27, 33, 34, 31, 13, 40, 58, 24}},
+	47: {Name: "2 Corinthians", SingularName: "2 Corinthians", Abbreviations: []string{"2 co", "2 cor", "2nd co", "2nd cor", "2nd corinthians", "ii co", "ii cor", "ii corinthians", "second co", "second cor", "second corinthians"}, Chapters: []int{24, 17, 18, 18, 21, 18, 16, 24, 15, 18, 33, 21, 14}},
+	48: {Name: "Galatians", SingularName: "Galatians", Abbreviations: []string{"ga", "gal"}, Chapters: []int{24, 21, 29, 31, 26, 18}},
+	49: {Name: "Ephesians", SingularName: "Ephesians", Abbreviations: []string{"eph", "ephes"}, Chapters: []int{23, 22, 21, 32, 33, 24}},
+	50: {Name: "Philippians", SingularName: "Philippians", Abbreviations: []string{"phil", "pp"}, Chapters: []int{30, 30, 21, 23}},
+	51: {Name: "Colossians", SingularName: "Colossians", Abbreviations: []string{"co", "col"}, Chapters: []int{29, 23, 25, 18}},
+	52: {Name: "1 Thessalonians", SingularName: "1 Thessalonians", Abbreviations: []string{"1 th", "1 thes", "1 thess", "1st th", "1st thes", "1st thess", "1st thessalonians", "first th", "first thes", "first thess", "first thessalonians", "i th", "i thes", "i thess", "i thessalonians"}, Chapters: []int{10, 20, 13, 18, 28}},
+	53: {Name: "2 Thessalonians", SingularName: "2 Thessalonians", Abbreviations: []string{"2 th", "2 thes", "2 thess", "2nd th", "2nd thes", "2nd thess", "2nd thessalonians", "ii th", "ii thes", "ii thess", "ii thessalonians", "second th", "second thes", "second thess", "second thessalonians"}, Chapters: []int{12, 17, 18}},
+	54: {Name: "1 Timothy", SingularName: "1 Timothy", Abbreviations: []string{"1 ti", "1 tim", "1st ti", "1st tim", "1st timothy", "first ti", "first tim", "first timothy", "i ti", "i tim", "i timothy"}, Chapters: []int{20, 15, 16, 16, 25, 21}},
+	55: {Name: "2 Timothy", SingularName: "2 Timothy", Abbreviations: []string{"2 ti", "2 tim", "2nd ti", "2nd tim", "2nd timothy", "ii ti", "ii tim", "ii timothy", "second ti", "second tim", "second timothy"}, Chapters: []int{18, 26, 17, 22}},
+	56: {Name: "Titus", SingularName: "Titus", Abbreviations: []string{"ti"}, Chapters: []int{16, 15, 15}},
+	57: {Name: "Philemon", SingularName: "Philemon", Abbreviations: []string{"philem", "phlm", "pm"}, Chapters: []int{25}},
+	58: {Name: "Hebrews", SingularName: "Hebrews", Abbreviations: []string{"heb"}, Chapters: []int{14, 18, 19, 16, 14, 20, 28, 13, 28, 39, 40, 29, 25}},
+	59: {Name: "James", SingularName: "James", Abbreviations: []string{"jam", "jas", "jm"}, Chapters: []int{27, 26, 18, 17, 20}},
+	60: {Name: "1 Peter", SingularName: "1 Peter", Abbreviations: []string{"1 p", "1 pe", "1 pet", "1 pt", "1st p", "1st pe", "1st pet", "1st peter", "1st pt", "first p", "first pe", "first pet", "first peter", "first pt", "i p", "i pe", "i pet", "i peter", "i pt"}, Chapters: []int{25, 25, 22, 19, 14}},
+	61: {Name: "2 Peter", SingularName: "2 Peter", Abbreviations: []string{"2 p", "2 pe", "2 pet", "2 pt", "2nd p", "2nd pe", "2nd pet", "2nd peter", "2nd pt", "ii p", "ii pe", "ii pet", "ii peter", "ii pt", "second p", "second pe", "second pet", "second peter", "second pt"}, Chapters: []int{21, 22, 18}},
+	62: {Name: "1 John", SingularName: "1 John", Abbreviations: []string{"1 j", "1 jhn", "1 jn", "1 jo", "1 joh", "1st j", "1st jhn", "1st jn", "1st jo", "1st joh", "1st john", "first j", "first jhn", "first jn", "first jo", "first joh", "first john", "i j", "i jhn", "i jn", "i jo", "i joh", "i john"}, Chapters: []int{10, 29, 24, 21, 21}},
+	63: {Name: "2 John", SingularName: "2 John", Abbreviations: []string{"2 j", "2 jhn", "2 jn", "2 jo", "2 joh", "2nd j", "2nd jhn", "2nd jn", "2nd jo", "2nd joh", "2nd john", "ii j", "ii jhn", "ii jn", "ii jo", "ii joh", "ii john", "second j", "second jhn", "second jn", "second jo", "second joh", "second john"}, Chapters: []int{13}},
+	64: {Name: "3 John", SingularName: "3 John", Abbreviations: []string{"3 j", "3 jhn", "3 jn", "3 jo", "3 joh", "3rd j", "3rd jhn", "3rd jn", "3rd jo", "3rd joh", "3rd john", "iii j", "iii jhn", "iii jn", "iii jo", "iii joh", "iii john", "third j", "third jhn", "third jn", "third jo", "third joh", "third john"}, Chapters: []int{15}},
+	65: {Name: "Jude", SingularName: "Jude", Abbreviations: []string{"jd"}, Chapters: []int{25}},
+	66: {Name: "Revelation", SingularName: "Revelation", Abbreviations: []string{"re", "rev", "the revelation"}, Chapters: []int{20, 29, 22, 11, 14, 17, 17, 13, 21, 11, 19, 17, 18, 20, 8, 21, 18, 24, 21, 15, 27, 21}},
 }
@@ -0,0 +1,18 @@
+package parser
+
+// ParseError describes why an input could not be parsed. Start and End are the
+// byte offsets of the offending part of Input.
+type ParseError struct {
+	Input string
+	Start int
+	End   int
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,79 @@
+package parser
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Match is a passage reference found in free text by Extract. Start and End are
+// byte offsets into the original text.
+type Match struct {
+	Text     string
+	Start    int
+	End      int
+	Passages []*BiblePassage
+}
+
+// buildExtractRegex builds the candidate finder used by Extract from every known
+// book name and abbreviation. Longer names come first so that "1 John" wins over "John".
+func buildExtractRegex(bookAbbr map[string]int, fragments []rune) *regexp.Regexp {
+	number := `\d+[` + regexp.QuoteMeta(string(fragments)) + `]?`
+	reference := `(?:(?:ch(?:apter)?\.?\s*)?` + number + `(?:\s*(?:[:.\s]|vv?\.?|verses?)\s*` + number + `)?(?:\s*ff?\b)?)`
+	rangeEnd := `(?:\s*(?:[-–—]|to)\s*(?:end|` + reference + `))?`
+
+	names := make([]string, 0, len(bookAbbr))
+	for name := range bookAbbr {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) > len(names[j])
+		}
+		return names[i] < names[j]
+	})
+	for i, name := range names {
+		names[i] = strings.ReplaceAll(regexp.QuoteMeta(name), " ", `\s*`)
+	}
+	book := `(?:` + strings.Join(names, "|") + `)\.?\s*`
+	return regexp.MustCompile(`(?i)\b` + book + reference + rangeEnd +
+		`(?:\s*(?:[,;&]|and)\s*(?:` + book + `)?` + reference + rangeEnd + `)*`)
+}
+
+// Extract finds every parseable passage reference in text. A candidate must start
+// with a capitalised book name (or a book number) and contain at least a chapter,
+// which keeps words such as "is" or "am" in ordinary prose from being matched.
+func (p *BiblePassageParser) Extract(text string) []*Match {
+	matches := []*Match{}
+	for _, loc := range p.extractPattern().FindAllStringIndex(text, -1) {
+		candidate := text[loc[0]:loc[1]]
+		if r, _ := utf8.DecodeRuneInString(candidate); unicode.IsLetter(r) && !unicode.IsUpper(r) {
+			continue
+		}
+		passages, err := p.Parse(candidate)
+		if err != nil {
+			continue
+		}
+		matches = append(matches, &Match{Text: candidate, Start: loc[0], End: loc[1], Passages: passages})
+	}
+	return matches
+}
+
+// extractPattern returns the candidate finder, building it on first use (and again
+// after AddAbbreviation) as it is large.
+func (p *BiblePassageParser) extractPattern() *regexp.Regexp {
+	p.mu.RLock()
+	re := p.extractRegex
+	p.mu.RUnlock()
+	if re != nil {
+		return re
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.extractRegex == nil {
+		p.extractRegex = buildExtractRegex(p.bookAbbr, p.fragments)
+	}
+	return p.extractRegex
+}
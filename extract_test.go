@@ -0,0 +1,57 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	p := NewBiblePassageParser()
+
+	cases := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{"no references", "This is 5 apples and I am 3 years old.", []string{}},
+		{"single reference", "Read John 3:16 today.", []string{"John 3:16"}},
+		{"following verses", "Compare Rom 8:28f for more.", []string{"Romans 8:28-29"}},
+		{"list with book change", "See John 3:16-18 and 1 Cor. 13 later.", []string{"John 3:16-18; 1 Corinthians 13"}},
+		{"separate references", "Gen 1:1 – 2:3 was read. Then Ps 23, 24.", []string{"Genesis 1:1-2:3", "Psalm 23; Psalm 24"}},
+		{"book name without chapter is ignored", "John said that I Samuel 10:22 applies.", []string{"1 Samuel 10:22"}},
+		{"unparseable candidate is dropped", "Psalm 34-20. Jude 1:5", []string{"Jude 1:5"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := []string{}
+			for _, m := range p.Extract(c.in) {
+				if c.in[m.Start:m.End] != m.Text {
+					t.Fatalf("span %d-%d does not match text %q", m.Start, m.End, m.Text)
+				}
+				s := ""
+				for i, pass := range m.Passages {
+					if i > 0 {
+						s += "; "
+					}
+					s += pass.String()
+				}
+				got = append(got, s)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("mismatch for %q\n got: %#v\nwant: %#v", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestNormalise(t *testing.T) {
+	p := NewBiblePassageParser()
+	got, err := p.Normalise("jn 3v16-18 and ps 23")
+	if err != nil {
+		t.Fatalf("normalise error: %v", err)
+	}
+	if want := "John 3:16-18; Psalm 23"; got != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse_Following(t *testing.T) {
+	cases := []struct {
+		name string
+		opts []Option
+		in   string
+		want [][]string
+	}{
+		{"f is the next verse", nil, "Rom 8:28f", [][]string{{"Romans 8:28", "Romans 8:29"}}},
+		{"ff runs to the end of the chapter", nil, "John 3:16ff", [][]string{{"John 3:16", "John 3:36"}}},
+		{"ff with a space", nil, "John 3:16 ff", [][]string{{"John 3:16", "John 3:36"}}},
+		{"f on the last verse stays in the chapter", nil, "John 3:36f", [][]string{{"John 3:36", "John 3:36"}}},
+		{"configured ff", []Option{WithFollowing(3)}, "John 3:16ff", [][]string{{"John 3:16", "John 3:19"}}},
+		{"configured ff near the chapter end", []Option{WithFollowing(3)}, "John 3:35ff", [][]string{{"John 3:35", "John 3:36"}}},
+		{"f after a chapter", nil, "Gen 12f", [][]string{{"Genesis 12:1", "Genesis 13:18"}}},
+		{"ff after a chapter", nil, "Rev 21ff", [][]string{{"Revelation 21:1", "Revelation 22:21"}}},
+		{"following verses in a list", nil, "Rom 8:28f, 31ff", [][]string{{"Romans 8:28", "Romans 8:29"}, {"Romans 8:31", "Romans 8:39"}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := NewBiblePassageParser(c.opts...)
+			got, err := p.Parse(c.in)
+			if err != nil {
+				t.Fatalf("parse error for %q: %v", c.in, err)
+			}
+			gotPairs := make([][]string, len(got))
+			for i, pass := range got {
+				gotPairs[i] = []string{pass.From.String(), pass.To.String()}
+			}
+			if !reflect.DeepEqual(gotPairs, c.want) {
+				t.Fatalf("mismatch for %q\n got: %#v\nwant: %#v", c.in, gotPairs, c.want)
+			}
+		})
+	}
+}
+
+func TestParse_FollowingInvalid(t *testing.T) {
+	p := NewBiblePassageParser()
+
+	cases := []string{"John 3:16ff-18", "John 3:16-18ff", "John ff", "John 3:16fff"}
+	for _, c := range cases {
+		t.Run(c, func(t *testing.T) {
+			_, err := p.Parse(c)
+			if err == nil {
+				t.Fatalf("expected error for invalid input %q, got nil", c)
+			}
+		})
+	}
+}
+
+func TestPassage_FormatFF(t *testing.T) {
+	p := NewBiblePassageParser()
+
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{"John 3:16-36", "John 3:16ff"},
+		{"John 3:16-35", "John 3:16-35"},
+		{"John 3", "John 3"},
+		{"John 3:16", "John 3:16"},
+		{"John 3:16-4:54", "John 3:16-4:54"},
+		{"Psalm 51:title-19", "Psalm 51:title-19"},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			got, err := p.Parse(c.in)
+			if err != nil {
+				t.Fatalf("parse error for %q: %v", c.in, err)
+			}
+			if s := got[0].Format(FormatOptions{FF: true}); s != c.want {
+				t.Fatalf("got %q want %q", s, c.want)
+			}
+		})
+	}
+}
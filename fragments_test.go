@@ -0,0 +1,180 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse_FragmentAlphabet(t *testing.T) {
+	cases := []struct {
+		name string
+		opts []Option
+		in   string
+		want [][]string
+	}{
+		{"default d is not a fragment", nil, "John 3:16-17", [][]string{{"John 3:16", "John 3:17"}}},
+		{"extended latin", []Option{WithFragments("abcde")}, "John 3:16d", [][]string{{"John 3:16d", "John 3:16d"}}},
+		{"extended range", []Option{WithFragments("abcde")}, "John 3:16c-16e", [][]string{{"John 3:16c", "John 3:16e"}}},
+		{"upper case fragment", []Option{WithFragments("abcde")}, "John 3:16E", [][]string{{"John 3:16e", "John 3:16e"}}},
+		{"greek", []Option{WithFragments("αβγ")}, "John 3:16β", [][]string{{"John 3:16β", "John 3:16β"}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := NewBiblePassageParser(c.opts...)
+			got, err := p.Parse(c.in)
+			if err != nil {
+				t.Fatalf("parse error for %q: %v", c.in, err)
+			}
+			gotPairs := make([][]string, len(got))
+			for i, pass := range got {
+				gotPairs[i] = []string{pass.From.String(), pass.To.String()}
+			}
+			if !reflect.DeepEqual(gotPairs, c.want) {
+				t.Fatalf("mismatch for %q\n got: %#v\nwant: %#v", c.in, gotPairs, c.want)
+			}
+		})
+	}
+}
+
+func TestParse_FragmentAlphabetInvalid(t *testing.T) {
+	p := NewBiblePassageParser()
+	for _, in := range []string{"John 3:16d", "John 3:16β"} {
+		if _, err := p.Parse(in); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+	}
+}
+
+func TestPassage_Overlaps(t *testing.T) {
+	p := NewBiblePassageParser()
+	one := func(s string) *BiblePassage {
+		t.Helper()
+		got, err := p.Parse(s)
+		if err != nil || len(got) != 1 {
+			t.Fatalf("parse %q: %v", s, err)
+		}
+		return got[0]
+	}
+
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"John 3:16a", "John 3:16b", false},
+		{"John 3:16a", "John 3:16", true},
+		{"John 3:16b", "John 3:16", true},
+		{"John 3:16a-17", "John 3:16b", true},
+		{"John 3:15", "John 3:16a", false},
+		{"John 3:16b-18", "John 3:18a", true},
+		{"John 3", "John 4:1", false},
+	}
+	for _, c := range cases {
+		if got := one(c.a).Overlaps(one(c.b)); got != c.want {
+			t.Errorf("%q overlaps %q = %v, want %v", c.a, c.b, got, c.want)
+		}
+		if got := one(c.b).Overlaps(one(c.a)); got != c.want {
+			t.Errorf("%q overlaps %q = %v, want %v", c.b, c.a, got, c.want)
+		}
+	}
+
+	if one("John 3:16a").Contains(one("John 3:16").From) {
+		t.Error("John 3:16a must not contain the whole of John 3:16")
+	}
+	if !one("John 3:16").Contains(one("John 3:16b").From) {
+		t.Error("John 3:16 must contain John 3:16b")
+	}
+	if got := one("John 3:14-16a").Intersect(one("John 3:16")); got == nil || got.From.String() != "John 3:16" || got.To.String() != "John 3:16a" {
+		t.Errorf("intersection = %v, want John 3:16 to John 3:16a", got)
+	}
+	if got := one("John 3:16a-16c").String(); got != "John 3:16a-16c" {
+		t.Errorf("fragment range formats as %q", got)
+	}
+}
+
+func TestPassageSet(t *testing.T) {
+	p := NewBiblePassageParser()
+	set := func(s string) *PassageSet {
+		t.Helper()
+		got, err := p.Parse(s)
+		if err != nil {
+			t.Fatalf("parse %q: %v", s, err)
+		}
+		return NewPassageSet(got...)
+	}
+	normalised := func(s *PassageSet) string {
+		out := ""
+		for i, pass := range s.Passages() {
+			if i > 0 {
+				out += "; "
+			}
+			out += pass.String()
+		}
+		return out
+	}
+
+	cases := []struct {
+		name, in, want string
+	}{
+		{"fragments stay apart", "John 3:16a; John 3:16c", "John 3:16a; John 3:16c"},
+		{"fragment within a verse", "John 3:16; John 3:16b", "John 3:16"},
+		{"adjacent verses merge", "John 3:18; John 3:16-17", "John 3:16-18"},
+		{"adjacent chapters merge", "John 3:36; John 4:1-2", "John 3:36-4:2"},
+		{"fragment does not continue", "John 3:16a; John 3:17", "John 3:16a; John 3:17"},
+		{"books do not merge", "Gen 50:26; Exod 1:1", "Genesis 50:26; Exodus 1:1"},
+		{"psalm title and verses", "Ps 51:title; Ps 51:1-3", "Psalm 51:title-3"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := normalised(set(c.in)); got != c.want {
+				t.Errorf("got %q, want %q", got, c.want)
+			}
+		})
+	}
+
+	a, b := set("John 3:1-16a"), set("John 3:16b-4:2")
+	if got := normalised(a.Intersect(b)); got != "" {
+		t.Errorf("disjoint intersection = %q", got)
+	}
+	if got := normalised(a.Union(b)); got != "John 3:1-16a; John 3:16b-4:2" {
+		t.Errorf("union = %q", got)
+	}
+	if got := normalised(set("John 3").Intersect(set("John 3:16b-4:2"))); got != "John 3:16b-36" {
+		t.Errorf("intersection = %q", got)
+	}
+	if !a.Contains(set("John 3:16a").Passages()[0].From) || a.Contains(set("John 3:16b").Passages()[0].From) {
+		t.Error("Contains must respect fragments")
+	}
+	if !a.Overlaps(set("John 3:16").Passages()[0]) || a.Overlaps(set("John 4:3").Passages()[0]) {
+		t.Error("Overlaps must respect fragments")
+	}
+}
+
+func TestNewBibleReference_FragmentAlphabet(t *testing.T) {
+	cases := []struct {
+		opts     []Option
+		fragment string
+		valid    bool
+	}{
+		{nil, "a", true},
+		{nil, "c", true},
+		{nil, "z", false},
+		{nil, "A", false},
+		{nil, "ab", false},
+		{[]Option{WithFragments("abcde")}, "e", true},
+		{[]Option{WithFragments("αβγ")}, "β", true},
+		{[]Option{WithFragments("αβγ")}, "a", false},
+	}
+	for _, c := range cases {
+		john, _ := NewBiblePassageParser(c.opts...).Book("John")
+		if _, err := NewBibleReference(john, 3, 16, c.fragment); (err == nil) != c.valid {
+			t.Errorf("NewBibleReference(John 3:16%s) error = %v, want valid %v", c.fragment, err, c.valid)
+		}
+	}
+
+	// a book made without a parser takes any lower-case letter
+	b := NewBook(1, "Genesis", "Genesis", nil, map[int]int{1: 31})
+	if _, err := NewBibleReference(b, 1, 1, "z"); err != nil {
+		t.Errorf("NewBibleReference on a standalone book: %v", err)
+	}
+}
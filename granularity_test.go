@@ -0,0 +1,175 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPassage_IsWhole(t *testing.T) {
+	p := NewBiblePassageParser()
+	cases := []struct {
+		in           string
+		book, chapte bool
+	}{
+		{"Jude", true, true},
+		{"Genesis", true, false},
+		{"Genesis 1:1-50:26", true, false},
+		{"Genesis 1:1a-50:26", false, false},
+		{"Genesis 1-49", false, false},
+		{"John 3", false, true},
+		{"John 3:1-36", false, true},
+		{"John 3:1-36b", false, false},
+		{"John 3:2-36", false, false},
+		{"Psalm 51", false, true},
+		{"Psalm 51:title-19", false, false},
+		{"Genesis - Exodus 40:38", false, false},
+	}
+	for _, c := range cases {
+		pass := mustParse(t, p, c.in)[0]
+		if got := pass.IsWholeBook(); got != c.book {
+			t.Errorf("IsWholeBook(%s) = %v, want %v", c.in, got, c.book)
+		}
+		if got := pass.IsWholeChapter(); got != c.chapte {
+			t.Errorf("IsWholeChapter(%s) = %v, want %v", c.in, got, c.chapte)
+		}
+	}
+}
+
+func TestPassage_ChaptersAndBooks(t *testing.T) {
+	p := NewBiblePassageParser()
+	strs := func(passages []*BiblePassage) []string {
+		out := []string{}
+		for _, pass := range passages {
+			out = append(out, pass.String())
+		}
+		return out
+	}
+	cases := []struct {
+		in       string
+		chapters []string
+		books    []string
+	}{
+		{"John 3:16", []string{"John 3:16"}, []string{"John 3:16"}},
+		{"John 3:16b-5:2a", []string{"John 3:16b-36", "John 4", "John 5:1-2a"}, []string{"John 3:16b-5:2a"}},
+		{"Ruth", []string{"Ruth 1", "Ruth 2", "Ruth 3", "Ruth 4"}, []string{"Ruth"}},
+		{"Psalm 50:20 - 51:title", []string{"Psalm 50:20-23", "Psalm 51:title"}, []string{"Psalm 50:20-51:title"}},
+		{"Psalm 51:title-3", []string{"Psalm 51:title-3"}, []string{"Psalm 51:title-3"}},
+		{"Psalm 50:23-51:1", []string{"Psalm 50:23", "Psalm 51:title-1"}, []string{"Psalm 50:23-51:1"}},
+		{"Obadiah 1:21 - Jonah 1:2", []string{"Obadiah 1:21", "Jonah 1:1-2"}, []string{"Obadiah 1:21", "Jonah 1:1-2"}},
+		{"Jude 1:24 - Revelation 1:3", []string{"Jude 1:24-25", "Revelation 1:1-3"}, []string{"Jude 1:24-25", "Revelation 1:1-3"}},
+		{"1 John 5:21 - 3 John 1:2", []string{"1 John 5:21", "2 John", "3 John 1:1-2"}, []string{"1 John 5:21", "2 John", "3 John 1:1-2"}},
+	}
+	for _, c := range cases {
+		pass := mustParse(t, p, c.in)[0]
+		if got := strs(pass.Chapters()); !reflect.DeepEqual(got, c.chapters) {
+			t.Errorf("Chapters(%s) = %q, want %q", c.in, got, c.chapters)
+		}
+		if got := strs(pass.Books()); !reflect.DeepEqual(got, c.books) {
+			t.Errorf("Books(%s) = %q, want %q", c.in, got, c.books)
+		}
+	}
+}
+
+func TestPassage_ExpandToChapters(t *testing.T) {
+	p := NewBiblePassageParser()
+	cases := map[string]string{
+		"John 3:16":                "John 3",
+		"John 3:16b-4:2a":          "John 3-4",
+		"John 3":                   "John 3",
+		"Psalm 51:title-3":         "Psalm 51:title-19",
+		"Jude 1:5":                 "Jude",
+		"Obadiah 1:21 - Jonah 1:2": "Obadiah 1 - Jonah 1",
+	}
+	for in, want := range cases {
+		if got := mustParse(t, p, in)[0].ExpandToChapters().String(); got != want {
+			t.Errorf("ExpandToChapters(%s) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+// TestPassage_VerseZero checks that a reference to a chapter as verse 0 covers the
+// whole chapter wherever a passage is walked or compared.
+func TestPassage_VerseZero(t *testing.T) {
+	p := NewBiblePassageParser()
+	rom, err := p.Book("Romans")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := func(chapter, verse int) *BibleReference {
+		r, err := NewBibleReference(rom, chapter, verse, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	chapter := NewBiblePassage(ref(1, 0), ref(1, 0))
+	if n := len(chapter.Verses()); n != 32 {
+		t.Errorf("Romans 1 has %d verses, want 32", n)
+	}
+	set := NewPassageSet(chapter)
+	if !set.Contains(ref(1, 3)) || !set.Contains(ref(1, 32)) || set.Contains(ref(2, 1)) {
+		t.Errorf("PassageSet(Romans 1) = %v has the wrong verses", set.Passages())
+	}
+	if !chapter.Contains(ref(1, 3)) {
+		t.Errorf("Romans 1 does not contain Romans 1:3")
+	}
+
+	// "1:0-2:0" covers chapters 1 and 2 and stops there
+	two := NewBiblePassage(ref(1, 0), ref(2, 0))
+	verses := two.Verses()
+	if n := len(verses); n != 32+29 {
+		t.Errorf("Romans 1-2 has %d verses, want %d", n, 32+29)
+	}
+	if last := verses[len(verses)-1].String(); last != "Romans 2:29" {
+		t.Errorf("Romans 1-2 ends at %s, want Romans 2:29", last)
+	}
+}
+
+// TestPassage_VerseZeroEnds checks that IsWholeChapter, IsWholeBook and Format read
+// a verse-0 end as the whole chapter whichever end it is.
+func TestPassage_VerseZeroEnds(t *testing.T) {
+	p := NewBiblePassageParser()
+	ref := func(name string, chapter, verse int) *BibleReference {
+		book, err := p.Book(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := NewBibleReference(book, chapter, verse, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	cases := []struct {
+		from, to     *BibleReference
+		chapter, all bool
+		want         string
+	}{
+		{ref("John", 3, 0), ref("John", 3, 5), false, false, "John 3:1-5"},
+		{ref("John", 3, 5), ref("John", 3, 0), false, false, "John 3:5-36"},
+		{ref("John", 3, 1), ref("John", 3, 0), true, false, "John 3"},
+		{ref("John", 3, 0), ref("John", 3, 36), true, false, "John 3"},
+		{ref("John", 3, 0), ref("John", 4, 0), false, false, "John 3-4"},
+		{ref("John", 3, 0), ref("John", 4, 5), false, false, "John 3:1-4:5"},
+		{ref("Romans", 1, 0), ref("Romans", 16, 0), false, true, "Romans"},
+		{ref("Romans", 1, 1), ref("Romans", 16, 0), false, true, "Romans"},
+		{ref("Romans", 1, 0), ref("Romans", 16, 27), false, true, "Romans"},
+		{ref("Genesis", 50, 0), ref("Exodus", 1, 0), false, false, "Genesis 50 - Exodus 1"},
+		{ref("Genesis", 1, 0), ref("Exodus", 40, 0), false, false, "Genesis - Exodus"},
+		{ref("Genesis", 50, 0), ref("Exodus", 1, 5), false, false, "Genesis 50:1 - Exodus 1:5"},
+	}
+	for _, c := range cases {
+		pass := NewBiblePassage(c.from, c.to)
+		name := pass.String()
+		if got := pass.IsWholeChapter(); got != c.chapter {
+			t.Errorf("%s: IsWholeChapter() = %v, want %v", name, got, c.chapter)
+		}
+		if got := pass.IsWholeBook(); got != c.all {
+			t.Errorf("%s: IsWholeBook() = %v, want %v", name, got, c.all)
+		}
+		if name != c.want {
+			t.Errorf("Format(%d:%d-%d:%d) = %q, want %q", c.from.Chapter, c.from.Verse, c.to.Chapter, c.to.Verse, name, c.want)
+		}
+	}
+}
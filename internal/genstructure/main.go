@@ -0,0 +1,110 @@
+// Command genstructure writes data/static_bible_structure.go from the canonical
+// book table in data/bible_structure.json. Abbreviations are lower-cased,
+// deduplicated and sorted, and books are written in order. Run it with
+//
+//	go generate ./data
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+type book struct {
+	Number        int      `json:"number"`
+	Name          string   `json:"name"`
+	SingularName  string   `json:"singular_name"`
+	Abbreviations []string `json:"abbreviations"`
+	Chapters      []int    `json:"chapters"`
+}
+
+func main() {
+	in := flag.String("in", "bible_structure.json", "canonical JSON book table")
+	out := flag.String("out", "static_bible_structure.go", "Go file to write")
+	flag.Parse()
+
+	src, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	code, err := generate(src)
+	if err != nil {
+		log.Fatalf("%s: %v", *in, err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted Go source for the JSON book table src.
+func generate(src []byte) ([]byte, error) {
+	var books []book
+	if err := json.Unmarshal(src, &books); err != nil {
+		return nil, err
+	}
+	sort.Slice(books, func(i, j int) bool { return books[i].Number < books[j].Number })
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by genstructure from bible_structure.json. DO NOT EDIT.\n\n")
+	b.WriteString("package data\n\n")
+	b.WriteString("var BibleStructure = map[int]BookData{\n")
+	for i, bk := range books {
+		if bk.Number != i+1 {
+			return nil, fmt.Errorf("book numbers must run from 1 without gaps: found %d after %d", bk.Number, i)
+		}
+		if bk.Name == "" || len(bk.Chapters) == 0 {
+			return nil, fmt.Errorf("book %d needs a name and chapters", bk.Number)
+		}
+		for ch, n := range bk.Chapters {
+			if n < 1 {
+				return nil, fmt.Errorf("%s %d has %d verses", bk.Name, ch+1, n)
+			}
+		}
+		if bk.SingularName == "" {
+			bk.SingularName = bk.Name
+		}
+		fmt.Fprintf(&b, "\t%d: {Name: %q, SingularName: %q, Abbreviations: %s, Chapters: %s},\n",
+			bk.Number, bk.Name, bk.SingularName, stringSlice(abbreviations(bk.Abbreviations)), intSlice(bk.Chapters))
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+// abbreviations lower-cases the abbreviations, collapses their spaces, and
+// returns them sorted without duplicates.
+func abbreviations(abbr []string) []string {
+	seen := map[string]bool{}
+	out := []string{}
+	for _, a := range abbr {
+		a = strings.ToLower(strings.Join(strings.Fields(a), " "))
+		if a != "" && !seen[a] {
+			seen[a] = true
+			out = append(out, a)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func stringSlice(ss []string) string {
+	quoted := make([]string, len(ss))
+	for i, s := range ss {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+func intSlice(ns []int) string {
+	s := make([]string, len(ns))
+	for i, n := range ns {
+		s[i] = fmt.Sprint(n)
+	}
+	return "[]int{" + strings.Join(s, ", ") + "}"
+}
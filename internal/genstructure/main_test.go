@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// TestGeneratedFileIsCurrent fails when data/static_bible_structure.go differs
+// from what the generator makes of data/bible_structure.json.
+func TestGeneratedFileIsCurrent(t *testing.T) {
+	src, err := os.ReadFile("../../data/bible_structure.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := generate(src)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	got, err := os.ReadFile("../../data/static_bible_structure.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatal("data/static_bible_structure.go is out of date: run go generate ./data")
+	}
+}
+
+func TestAbbreviations(t *testing.T) {
+	got := abbreviations([]string{"i pet", "1 Pet", "1  pet", "i pet", "", "1pe"})
+	want := []string{"1 pet", "1pe", "i pet"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("abbreviations() = %q, want %q", got, want)
+	}
+}
+
+func TestGenerate_Invalid(t *testing.T) {
+	for _, src := range []string{
+		`not json`,
+		`[{"number": 2, "name": "Exodus", "chapters": [22]}]`,
+		`[{"number": 1, "name": "Genesis", "chapters": []}]`,
+		`[{"number": 1, "name": "Genesis", "chapters": [31, 0]}]`,
+	} {
+		if _, err := generate([]byte(src)); err == nil {
+			t.Errorf("generate(%s) succeeded, want error", src)
+		}
+	}
+}
@@ -0,0 +1,326 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// PassageIndex answers "which documents cover this verse?" for a collection of
+// documents tagged with passages. It is an interval tree (an AVL tree ordered by
+// where passages start, each node holding the furthest end below it), so Insert,
+// Remove and queries take logarithmic time plus the size of the answer. Positions
+// are fragment-aware: a document tagged "John 3:16a" does not cover John 3:16b.
+// A PassageIndex is safe for concurrent use.
+type PassageIndex struct {
+	mu   sync.RWMutex
+	root *intervalNode
+	size int
+}
+
+type intervalNode struct {
+	start, end  position
+	passage     *BiblePassage
+	doc         string
+	maxEnd      position
+	height      int
+	left, right *intervalNode
+}
+
+// NewPassageIndex returns an empty index.
+func NewPassageIndex() *PassageIndex {
+	return &PassageIndex{}
+}
+
+// Len is the number of (passage, document) entries in the index.
+func (ix *PassageIndex) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return ix.size
+}
+
+// Insert tags doc with passage. Inserting the same pair twice has no effect.
+func (ix *PassageIndex) Insert(passage *BiblePassage, doc string) {
+	n := &intervalNode{start: passage.From.startPosition(), end: passage.To.endPosition(), passage: copyPassages([]*BiblePassage{passage})[0], doc: doc, height: 1}
+	n.maxEnd = n.end
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	var added bool
+	ix.root, added = insertNode(ix.root, n)
+	if added {
+		ix.size++
+	}
+}
+
+// Remove removes the tag of doc with passage, reporting whether it was present.
+func (ix *PassageIndex) Remove(passage *BiblePassage, doc string) bool {
+	k := &intervalNode{start: passage.From.startPosition(), end: passage.To.endPosition(), doc: doc}
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	var removed bool
+	ix.root, removed = removeNode(ix.root, k)
+	if removed {
+		ix.size--
+	}
+	return removed
+}
+
+// Query returns the documents with a passage overlapping ref, sorted.
+func (ix *PassageIndex) Query(ref *BibleReference) []string {
+	return ix.QueryOverlapping(NewBiblePassage(ref, ref))
+}
+
+// QueryOverlapping returns the documents with a passage overlapping passage, sorted.
+func (ix *PassageIndex) QueryOverlapping(passage *BiblePassage) []string {
+	seen := map[string]bool{}
+	for _, e := range ix.Overlapping(passage) {
+		seen[e.Doc] = true
+	}
+	docs := make([]string, 0, len(seen))
+	for doc := range seen {
+		docs = append(docs, doc)
+	}
+	sort.Strings(docs)
+	return docs
+}
+
+// IndexEntry is a passage a document is tagged with.
+type IndexEntry struct {
+	Passage *BiblePassage
+	Doc     string
+}
+
+// Overlapping returns every entry whose passage overlaps passage, ordered by where
+// the passages start.
+func (ix *PassageIndex) Overlapping(passage *BiblePassage) []IndexEntry {
+	from, to := passage.From.startPosition(), passage.To.endPosition()
+	entries := []IndexEntry{}
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	var walk func(n *intervalNode)
+	walk = func(n *intervalNode) {
+		if n == nil || n.maxEnd.compare(from) < 0 {
+			return
+		}
+		walk(n.left)
+		if n.start.compare(to) > 0 {
+			return // everything to the right starts later still
+		}
+		if from.compare(n.end) <= 0 {
+			entries = append(entries, IndexEntry{Passage: n.passage, Doc: n.doc})
+		}
+		walk(n.right)
+	}
+	walk(ix.root)
+	return entries
+}
+
+func (n *intervalNode) compare(o *intervalNode) int {
+	if c := n.start.compare(o.start); c != 0 {
+		return c
+	}
+	if c := n.end.compare(o.end); c != 0 {
+		return c
+	}
+	switch {
+	case n.doc < o.doc:
+		return -1
+	case n.doc > o.doc:
+		return 1
+	}
+	return 0
+}
+
+func height(n *intervalNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the height and furthest end of n from its children.
+func (n *intervalNode) update() {
+	n.height = 1 + height(n.left)
+	if h := 1 + height(n.right); h > n.height {
+		n.height = h
+	}
+	n.maxEnd = n.end
+	for _, c := range []*intervalNode{n.left, n.right} {
+		if c != nil && c.maxEnd.compare(n.maxEnd) > 0 {
+			n.maxEnd = c.maxEnd
+		}
+	}
+}
+
+func rotateRight(n *intervalNode) *intervalNode {
+	l := n.left
+	n.left, l.right = l.right, n
+	n.update()
+	l.update()
+	return l
+}
+
+func rotateLeft(n *intervalNode) *intervalNode {
+	r := n.right
+	n.right, r.left = r.left, n
+	n.update()
+	r.update()
+	return r
+}
+
+func balance(n *intervalNode) *intervalNode {
+	n.update()
+	switch bf := height(n.left) - height(n.right); {
+	case bf > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case bf < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+func insertNode(n, x *intervalNode) (*intervalNode, bool) {
+	if n == nil {
+		return x, true
+	}
+	var added bool
+	switch c := x.compare(n); {
+	case c < 0:
+		n.left, added = insertNode(n.left, x)
+	case c > 0:
+		n.right, added = insertNode(n.right, x)
+	default:
+		return n, false
+	}
+	return balance(n), added
+}
+
+func removeNode(n, x *intervalNode) (*intervalNode, bool) {
+	if n == nil {
+		return nil, false
+	}
+	var removed bool
+	switch c := x.compare(n); {
+	case c < 0:
+		n.left, removed = removeNode(n.left, x)
+	case c > 0:
+		n.right, removed = removeNode(n.right, x)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		// replace n with the first node of its right subtree
+		m := n.right
+		for m.left != nil {
+			m = m.left
+		}
+		m.right, _ = removeNode(n.right, m)
+		m.left = n.left
+		return balance(m), true
+	}
+	return balance(n), removed
+}
+
+// indexFile is the serialised form of a PassageIndex.
+type indexFile struct {
+	Entries []indexFileEntry `json:"entries"`
+}
+
+type indexFileEntry struct {
+	Doc  string       `json:"doc"`
+	From indexFileRef `json:"from"`
+	To   indexFileRef `json:"to"`
+}
+
+type indexFileRef struct {
+	Book           int    `json:"book"`
+	Chapter        int    `json:"chapter"`
+	Verse          int    `json:"verse"`
+	Fragment       string `json:"fragment,omitempty"`
+	Superscription bool   `json:"superscription,omitempty"`
+}
+
+// WriteTo saves the index as JSON, to be loaded again with ReadPassageIndex.
+func (ix *PassageIndex) WriteTo(w io.Writer) (int64, error) {
+	file := indexFile{Entries: []indexFileEntry{}}
+	ix.mu.RLock()
+	var walk func(n *intervalNode)
+	walk = func(n *intervalNode) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		file.Entries = append(file.Entries, indexFileEntry{Doc: n.doc, From: toIndexFileRef(n.passage.From), To: toIndexFileRef(n.passage.To)})
+		walk(n.right)
+	}
+	walk(ix.root)
+	ix.mu.RUnlock()
+
+	b, err := json.Marshal(file)
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(b)
+	return int64(n), err
+}
+
+func toIndexFileRef(r *BibleReference) indexFileRef {
+	return indexFileRef{Book: r.Book.Number, Chapter: r.Chapter, Verse: r.Verse, Fragment: r.Fragment, Superscription: r.Superscription}
+}
+
+// ReadPassageIndex loads an index saved with WriteTo, resolving books with p.
+func (p *BiblePassageParser) ReadPassageIndex(r io.Reader) (*PassageIndex, error) {
+	var file indexFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("reading passage index: %w", err)
+	}
+	ix := NewPassageIndex()
+	for i, e := range file.Entries {
+		from, err := p.fromIndexFileRef(e.From)
+		if err != nil {
+			return nil, fmt.Errorf("reading passage index: entry %d: %w", i, err)
+		}
+		to, err := p.fromIndexFileRef(e.To)
+		if err != nil {
+			return nil, fmt.Errorf("reading passage index: entry %d: %w", i, err)
+		}
+		ix.Insert(NewBiblePassage(from, to), e.Doc)
+	}
+	return ix, nil
+}
+
+func (p *BiblePassageParser) fromIndexFileRef(r indexFileRef) (*BibleReference, error) {
+	b, ok := p.books[r.Book]
+	if !ok {
+		return nil, fmt.Errorf("invalid book number \"%d\"", r.Book)
+	}
+	if r.Superscription {
+		return NewSuperscriptionReference(b, r.Chapter)
+	}
+	ref, err := NewBibleReference(b, r.Chapter, r.Verse, r.Fragment)
+	if err != nil {
+		return nil, err
+	}
+	if ref.wholeChapter() {
+		// verse 0 is the whole chapter, which only needs to exist
+		if _, err := b.VersesInChapter(r.Chapter); err != nil {
+			return nil, err
+		}
+		return ref, nil
+	}
+	if err := checkReference(ref); err != nil {
+		return nil, err
+	}
+	return ref, nil
+}
@@ -0,0 +1,186 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPassageIndex(t *testing.T) {
+	p := NewBiblePassageParser()
+	ix := NewPassageIndex()
+	tag := func(s, doc string) {
+		for _, pass := range mustParse(t, p, s) {
+			ix.Insert(pass, doc)
+		}
+	}
+	tag("John 3:16", "sermon-1")
+	tag("John 3:1-21", "sermon-2")
+	tag("John 3:16a", "article-1")
+	tag("John 3:16b-18", "article-2")
+	tag("John 1-4", "series")
+	tag("Romans 8", "sermon-3")
+	tag("Genesis 1:1 - Exodus 2:3", "overview")
+	tag("Psalm 51:title-4", "psalm")
+	tag("John 3:16", "sermon-1") // duplicate
+
+	if got := ix.Len(); got != 8 {
+		t.Fatalf("Len() = %d, want 8", got)
+	}
+
+	query := func(s string) []string {
+		return ix.QueryOverlapping(mustParse(t, p, s)[0])
+	}
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"John 3:16", []string{"article-1", "article-2", "series", "sermon-1", "sermon-2"}},
+		{"John 3:16a", []string{"article-1", "series", "sermon-1", "sermon-2"}},
+		{"John 3:16c", []string{"article-2", "series", "sermon-1", "sermon-2"}},
+		{"John 3:22", []string{"series"}},
+		{"John 5", []string{}},
+		{"Romans 8:28", []string{"sermon-3"}},
+		{"Romans 7:25-8:1", []string{"sermon-3"}},
+		{"Exodus 2", []string{"overview"}},
+		{"Exodus 2:4", []string{}},
+		{"Psalm 51:title", []string{"psalm"}},
+		{"Psalm 50", []string{}},
+		{"Genesis 50:26 - John 1:1", []string{"overview", "psalm", "series"}},
+	}
+	for _, c := range cases {
+		if got := query(c.in); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("QueryOverlapping(%q) = %v, want %v", c.in, got, c.want)
+		}
+	}
+
+	ref := mustParse(t, p, "John 3:17")[0].From
+	if got, want := ix.Query(ref), []string{"article-2", "series", "sermon-2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Query(John 3:17) = %v, want %v", got, want)
+	}
+
+	if !ix.Remove(mustParse(t, p, "John 1-4")[0], "series") {
+		t.Fatal("Remove(series) = false, want true")
+	}
+	if ix.Remove(mustParse(t, p, "John 1-4")[0], "series") {
+		t.Fatal("second Remove(series) = true, want false")
+	}
+	if ix.Remove(mustParse(t, p, "John 3:16")[0], "sermon-2") {
+		t.Fatal("Remove with the wrong passage = true, want false")
+	}
+	if got := query("John 3:22"); len(got) != 0 {
+		t.Errorf("after Remove, QueryOverlapping(John 3:22) = %v, want none", got)
+	}
+	if got := ix.Len(); got != 7 {
+		t.Errorf("after Remove, Len() = %d, want 7", got)
+	}
+}
+
+func TestPassageIndex_Overlapping(t *testing.T) {
+	p := NewBiblePassageParser()
+	ix := NewPassageIndex()
+	for i, s := range []string{"John 3:18", "John 3:1-21", "John 3:16a"} {
+		ix.Insert(mustParse(t, p, s)[0], fmt.Sprint(i))
+	}
+	var got []string
+	for _, e := range ix.Overlapping(mustParse(t, p, "John 3")[0]) {
+		got = append(got, e.Passage.String()+"="+e.Doc)
+	}
+	want := []string{"John 3:1-21=1", "John 3:16a=2", "John 3:18=0"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Overlapping(John 3) = %v, want %v", got, want)
+	}
+}
+
+// TestPassageIndex_Balanced checks the tree against a linear scan while inserting
+// and removing enough entries to exercise every rotation.
+func TestPassageIndex_Balanced(t *testing.T) {
+	p := NewBiblePassageParser()
+	john := p.books[43]
+	ix := NewPassageIndex()
+	var all []IndexEntry
+	for ch := 1; ch <= john.ChaptersInBook(); ch++ {
+		vmax, _ := john.VersesInChapter(ch)
+		for v := 1; v <= vmax; v += 3 {
+			end := v + (v*7)%11
+			if end > vmax {
+				end = vmax
+			}
+			from, _ := NewBibleReference(john, ch, v, "")
+			to, _ := NewBibleReference(john, ch, end, "")
+			pass := NewBiblePassage(from, to)
+			doc := fmt.Sprintf("doc-%d-%d", ch, v)
+			ix.Insert(pass, doc)
+			all = append(all, IndexEntry{pass, doc})
+		}
+	}
+	for i := 0; i < len(all); i += 2 {
+		if !ix.Remove(all[i].Passage, all[i].Doc) {
+			t.Fatalf("Remove(%s, %s) = false", all[i].Passage, all[i].Doc)
+		}
+	}
+	if h, n := height(ix.root), ix.Len(); 1<<(h-1) > 2*n {
+		t.Errorf("tree of %d entries has height %d", n, h)
+	}
+
+	for ch := 1; ch <= john.ChaptersInBook(); ch++ {
+		vmax, _ := john.VersesInChapter(ch)
+		for v := 1; v <= vmax; v++ {
+			ref, _ := NewBibleReference(john, ch, v, "")
+			want := []string{}
+			for i := 1; i < len(all); i += 2 {
+				if all[i].Passage.Contains(ref) {
+					want = append(want, all[i].Doc)
+				}
+			}
+			sort.Strings(want)
+			if got := ix.Query(ref); !reflect.DeepEqual(got, want) {
+				t.Fatalf("Query(%s) = %v, want %v", ref, got, want)
+			}
+		}
+	}
+}
+
+func TestPassageIndex_ReadWrite(t *testing.T) {
+	p := NewBiblePassageParser()
+	ix := NewPassageIndex()
+	for doc, s := range map[string]string{"a": "John 3:16b-18", "b": "Psalm 51:title-4", "c": "Genesis 50 - Exodus 1", "d": "Jude"} {
+		ix.Insert(mustParse(t, p, s)[0], doc)
+	}
+	john, _ := p.Book("John")
+	chapter, _ := NewBibleReference(john, 3, 0, "")
+	ix.Insert(NewBiblePassage(chapter, chapter), "e")
+
+	var buf bytes.Buffer
+	n, err := ix.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("WriteTo returned %d, wrote %d bytes", n, buf.Len())
+	}
+	got, err := p.ReadPassageIndex(&buf)
+	if err != nil {
+		t.Fatalf("ReadPassageIndex: %v", err)
+	}
+	if !reflect.DeepEqual(got.Overlapping(mustParse(t, p, "Genesis - Revelation")[0]), ix.Overlapping(mustParse(t, p, "Genesis - Revelation")[0])) {
+		t.Errorf("read index differs from written index")
+	}
+	if docs := got.Query(mustParse(t, p, "John 3:30")[0].From); !reflect.DeepEqual(docs, []string{"e"}) {
+		t.Errorf("Query(John 3:30) after reading = %v, want the whole chapter e", docs)
+	}
+
+	for _, in := range []string{
+		`{"entries":[{"doc":"x","from":{"book":99,"chapter":1,"verse":1},"to":{"book":99,"chapter":1,"verse":1}}]}`,
+		`{"entries":[{"doc":"x","from":{"book":43,"chapter":3,"verse":37},"to":{"book":43,"chapter":3,"verse":37}}]}`,
+		`{"entries":[{"doc":"x","from":{"book":43,"chapter":3,"verse":0,"superscription":true},"to":{"book":43,"chapter":3,"verse":1}}]}`,
+		`{"entries":[{"doc":"x","from":{"book":43,"chapter":22,"verse":0},"to":{"book":43,"chapter":22,"verse":0}}]}`,
+		`not json`,
+	} {
+		if _, err := p.ReadPassageIndex(bytes.NewBufferString(in)); err == nil {
+			t.Errorf("ReadPassageIndex(%s) succeeded, want error", in)
+		}
+	}
+}
@@ -5,7 +5,7 @@ import "testing"
 func TestParse_InvalidVerses(t *testing.T) {
 	p := NewBiblePassageParser()
 
-	cases := []string{"", "Psalm 34-20"}
+	cases := []string{"", "Psalm 34-20", "John 3:16b-16a", "John 3:16c-b"}
 	for _, c := range cases {
 		t.Run(c, func(t *testing.T) {
 			_, err := p.Parse(c)
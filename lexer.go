@@ -0,0 +1,226 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type TokenKind int
+
+const (
+	TokenInvalid TokenKind = iota
+	// TokenBook is a book name or abbreviation; Value holds the book number.
+	TokenBook
+	// TokenNumber is a chapter or verse number; Value holds the number.
+	TokenNumber
+	// TokenFragment is a verse part such as the "b" in "16b".
+	TokenFragment
+	// TokenRange separates the two ends of a range: "-", "–", "—" or "to".
+	TokenRange
+	// TokenSeparator separates passages in a list: ",", ";", "&" or "and".
+	TokenSeparator
+	// TokenChapterMarker is "c", "ch" or "chapter".
+	TokenChapterMarker
+	// TokenVerseMarker is ":", "." between numbers, "v", "vv", "verse" or "verses".
+	TokenVerseMarker
+	// TokenEnd is the "end" keyword (last chapter of a book or last verse of a chapter).
+	TokenEnd
+	// TokenStart is the "start" keyword.
+	TokenStart
+	// TokenFollowing is "f" or "ff", the following verse(s).
+	TokenFollowing
+	// TokenTitle is "title" or "superscription", the title of a Psalm.
+	TokenTitle
+	// TokenWord is any other word, typically an unknown book name.
+	TokenWord
+)
+
+var tokenKindNames = map[TokenKind]string{
+	TokenInvalid:       "invalid",
+	TokenBook:          "book",
+	TokenNumber:        "number",
+	TokenFragment:      "fragment",
+	TokenRange:         "range",
+	TokenSeparator:     "separator",
+	TokenChapterMarker: "chapter marker",
+	TokenVerseMarker:   "verse marker",
+	TokenEnd:           "end",
+	TokenStart:         "start",
+	TokenFollowing:     "following",
+	TokenTitle:         "title",
+	TokenWord:          "word",
+}
+
+func (k TokenKind) String() string {
+	return tokenKindNames[k]
+}
+
+// Token is a lexical unit of a reference string. Start and End are byte offsets
+// into the input, so input[Start:End] == Text.
+type Token struct {
+	Kind  TokenKind
+	Text  string
+	Start int
+	End   int
+	Value int
+}
+
+// keywords classifies the words that are not book names.
+var keywords = map[string]TokenKind{
+	"to":      TokenRange,
+	"c":       TokenChapterMarker,
+	"ch":      TokenChapterMarker,
+	"chapter": TokenChapterMarker,
+	"v":       TokenVerseMarker,
+	"vv":      TokenVerseMarker,
+	"verse":   TokenVerseMarker,
+	"verses":  TokenVerseMarker,
+	"end":     TokenEnd,
+	"start":   TokenStart,
+	"f":       TokenFollowing,
+	"ff":      TokenFollowing,
+
+	"title":          TokenTitle,
+	"superscription": TokenTitle,
+}
+
+// Tokenize splits versesString into tokens. Book names made of several words
+// ("1 John", "Song of Solomon") are returned as a single TokenBook.
+func (p *BiblePassageParser) Tokenize(versesString string) ([]Token, error) {
+	raw, err := p.scan(versesString)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	tokens := make([]Token, 0, len(raw))
+	for i := 0; i < len(raw); i++ {
+		t := raw[i]
+		if t.Kind != TokenWord && t.Kind != TokenNumber {
+			tokens = append(tokens, t)
+			continue
+		}
+		if book, n := p.matchBook(raw[i:]); n > 0 {
+			last := raw[i+n-1]
+			tokens = append(tokens, Token{Kind: TokenBook, Text: versesString[t.Start:last.End], Start: t.Start, End: last.End, Value: book})
+			i += n - 1
+			continue
+		}
+		if t.Kind == TokenWord {
+			word := strings.ToLower(t.Text)
+			if p.isSeparator(word) {
+				t.Kind = TokenSeparator
+			} else if kind, ok := keywords[word]; ok {
+				t.Kind = kind
+			}
+		}
+		tokens = append(tokens, t)
+	}
+	return tokens, nil
+}
+
+// scan produces the raw tokens: numbers, fragments, words and punctuation.
+func (p *BiblePassageParser) scan(s string) ([]Token, error) {
+	tokens := []Token{}
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case '0' <= r && r <= '9':
+			j := i
+			for j < len(s) && '0' <= s[j] && s[j] <= '9' {
+				j++
+			}
+			n, err := strconv.Atoi(s[i:j])
+			if err != nil {
+				return nil, &ParseError{Input: s, Start: i, End: j, Err: fmt.Errorf("number %q is out of range", s[i:j])}
+			}
+			tokens = append(tokens, Token{Kind: TokenNumber, Text: s[i:j], Start: i, End: j, Value: n})
+			if fr, frSize := utf8.DecodeRuneInString(s[j:]); p.isFragment(fr) {
+				if next, _ := utf8.DecodeRuneInString(s[j+frSize:]); !unicode.IsLetter(next) {
+					tokens = append(tokens, Token{Kind: TokenFragment, Text: s[j : j+frSize], Start: j, End: j + frSize})
+					j += frSize
+				}
+			}
+			i = j
+		case unicode.IsLetter(r):
+			j := i
+			for j < len(s) {
+				r, size := utf8.DecodeRuneInString(s[j:])
+				if !unicode.IsLetter(r) {
+					break
+				}
+				j += size
+			}
+			tokens = append(tokens, Token{Kind: TokenWord, Text: s[i:j], Start: i, End: j})
+			i = j
+		case r == '-' || r == '–' || r == '—':
+			tokens = append(tokens, Token{Kind: TokenRange, Text: s[i : i+size], Start: i, End: i + size})
+			i += size
+		case r == ':':
+			tokens = append(tokens, Token{Kind: TokenVerseMarker, Text: ":", Start: i, End: i + 1})
+			i++
+		case r == '.':
+			// a dot is a verse marker between numbers ("3.16"); after a word it is
+			// abbreviation punctuation ("Cor.", "vv.") and is dropped
+			if len(tokens) > 0 && (tokens[len(tokens)-1].Kind == TokenNumber || tokens[len(tokens)-1].Kind == TokenFragment) {
+				tokens = append(tokens, Token{Kind: TokenVerseMarker, Text: ".", Start: i, End: i + 1})
+			}
+			i++
+		case p.isSeparator(string(r)):
+			tokens = append(tokens, Token{Kind: TokenSeparator, Text: s[i : i+size], Start: i, End: i + size})
+			i += size
+		default:
+			return nil, &ParseError{Input: s, Start: i, End: i + size, Err: fmt.Errorf("unexpected character %q", r)}
+		}
+	}
+	return tokens, nil
+}
+
+// matchBook finds the longest run of words and numbers at the start of raw that
+// names a book. The run must end with a word, so "Esth 1" is Esther chapter 1.
+// It must be called with mu held.
+func (p *BiblePassageParser) matchBook(raw []Token) (int, int) {
+	limit := 0
+	for limit < len(raw) && limit < p.maxBookWords && (raw[limit].Kind == TokenWord || raw[limit].Kind == TokenNumber) {
+		limit++
+	}
+	for n := limit; n > 0; n-- {
+		if raw[n-1].Kind != TokenWord {
+			continue
+		}
+		words := make([]string, n)
+		for k := range words {
+			words[k] = raw[k].Text
+		}
+		if book, ok := p.bookAbbr[StandardiseString(strings.Join(words, " "))]; ok {
+			return book, n
+		}
+	}
+	return 0, 0
+}
+
+// isFragment reports whether r (in either case) is in the fragment alphabet.
+func (p *BiblePassageParser) isFragment(r rune) bool {
+	r = unicode.ToLower(r)
+	for _, f := range p.fragments {
+		if f == r {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *BiblePassageParser) isSeparator(s string) bool {
+	for _, sep := range p.separators {
+		if sep == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package parser
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	p := NewBiblePassageParser()
+
+	cases := []struct {
+		in   string
+		want []Token
+	}{
+		{"John 3:16", []Token{{TokenBook, "John", 0, 4, 43}, {TokenNumber, "3", 5, 6, 3}, {TokenVerseMarker, ":", 6, 7, 0}, {TokenNumber, "16", 7, 9, 16}}},
+		{"1Kings 1:1", []Token{{TokenBook, "1Kings", 0, 6, 11}, {TokenNumber, "1", 7, 8, 1}, {TokenVerseMarker, ":", 8, 9, 0}, {TokenNumber, "1", 9, 10, 1}}},
+		{"2 CH ch 13 v 01", []Token{{TokenBook, "2 CH", 0, 4, 14}, {TokenChapterMarker, "ch", 5, 7, 0}, {TokenNumber, "13", 8, 10, 13}, {TokenVerseMarker, "v", 11, 12, 0}, {TokenNumber, "01", 13, 15, 1}}},
+		{"Luke 24:36B–48", []Token{{TokenBook, "Luke", 0, 4, 42}, {TokenNumber, "24", 5, 7, 24}, {TokenVerseMarker, ":", 7, 8, 0}, {TokenNumber, "36", 8, 10, 36}, {TokenFragment, "B", 10, 11, 0}, {TokenRange, "–", 11, 14, 0}, {TokenNumber, "48", 14, 16, 48}}},
+		{"2 Cor. 5 to end", []Token{{TokenBook, "2 Cor", 0, 5, 47}, {TokenNumber, "5", 7, 8, 5}, {TokenRange, "to", 9, 11, 0}, {TokenEnd, "end", 12, 15, 0}}},
+		{"Song of Solomon 2 and Esth 1", []Token{{TokenBook, "Song of Solomon", 0, 15, 22}, {TokenNumber, "2", 16, 17, 2}, {TokenSeparator, "and", 18, 21, 0}, {TokenBook, "Esth", 22, 26, 17}, {TokenNumber, "1", 27, 28, 1}}},
+		{"Rom 8:28ff", []Token{{TokenBook, "Rom", 0, 3, 45}, {TokenNumber, "8", 4, 5, 8}, {TokenVerseMarker, ":", 5, 6, 0}, {TokenNumber, "28", 6, 8, 28}, {TokenFollowing, "ff", 8, 10, 0}}},
+		{"Bob 3.1", []Token{{TokenWord, "Bob", 0, 3, 0}, {TokenNumber, "3", 4, 5, 3}, {TokenVerseMarker, ".", 5, 6, 0}, {TokenNumber, "1", 6, 7, 1}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			got, err := p.Tokenize(c.in)
+			if err != nil {
+				t.Fatalf("tokenize error for %q: %v", c.in, err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("mismatch for %q\n got: %v\nwant: %v", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestParse_ErrorPositions(t *testing.T) {
+	p := NewBiblePassageParser()
+
+	cases := []struct {
+		in         string
+		msg        string
+		start, end int
+	}{
+		{"John (3)", `unexpected character '('`, 5, 6},
+		{"John 3:16 & Bob 4", `invalid book name "bob"`, 12, 15},
+		{"John 3:16 Acts", `unexpected book "Acts"`, 10, 14},
+		{"Gen 1:1; John 3:16-18-20", "Range is too complex", 9, 24},
+		{"John 3:40", "verse 40 does not exist in chapter 3 of book John", 0, 9},
+		{"John 99999999999999999999", `number "99999999999999999999" is out of range`, 5, 25},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			_, err := p.Parse(c.in)
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("expected *ParseError for %q, got %v", c.in, err)
+			}
+			if pe.Error() != c.msg || pe.Start != c.start || pe.End != c.end {
+				t.Fatalf("got %q [%d:%d] want %q [%d:%d]", pe.Error(), pe.Start, pe.End, c.msg, c.start, c.end)
+			}
+		})
+	}
+}
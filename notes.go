@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+)
+
+// NoteKind classifies the decisions reported by ParseDetailed.
+type NoteKind int
+
+const (
+	// NoteBookName is an abbreviation or other name read as a book ("Jn" is John).
+	NoteBookName NoteKind = iota + 1
+	// NoteCarriedOver is a reference without a book that takes the book before it.
+	NoteCarriedOver
+	// NoteChapterOrVerse is a number read as a chapter or a verse from what precedes it.
+	NoteChapterOrVerse
+	// NoteSingleChapterBook is a number reinterpreted because its book has one chapter.
+	NoteSingleChapterBook
+	// NoteEnd is the "end" keyword expanded to the last chapter or verse.
+	NoteEnd
+	// NoteFollowing is "f" or "ff" expanded to the verses or chapters it covers.
+	NoteFollowing
+	// NoteSuperscription is verse 0 read as the title of a Psalm.
+	NoteSuperscription
+	// NoteRewrite is input read differently from how it was written, or partly ignored.
+	NoteRewrite
+)
+
+var noteKindNames = map[NoteKind]string{
+	NoteBookName:          "book name",
+	NoteCarriedOver:       "carried over",
+	NoteChapterOrVerse:    "chapter or verse",
+	NoteSingleChapterBook: "single-chapter book",
+	NoteEnd:               "end",
+	NoteFollowing:         "following",
+	NoteSuperscription:    "superscription",
+	NoteRewrite:           "rewrite",
+}
+
+func (k NoteKind) String() string {
+	return noteKindNames[k]
+}
+
+// Note explains one decision the parser made. Start and End are the byte offsets
+// of the part of the input it is about.
+type Note struct {
+	Kind    NoteKind
+	Message string
+	Start   int
+	End     int
+}
+
+func (n Note) String() string {
+	return fmt.Sprintf("%d-%d %s: %s", n.Start, n.End, n.Kind, n.Message)
+}
+
+// tracer collects notes during a parse. A nil tracer records nothing, so the plain
+// Parse path pays nothing for it.
+type tracer struct {
+	notes []Note
+}
+
+func (t *tracer) add(kind NoteKind, start, end int, format string, args ...interface{}) {
+	if t == nil {
+		return
+	}
+	t.notes = append(t.notes, Note{Kind: kind, Message: fmt.Sprintf(format, args...), Start: start, End: end})
+}
+
+// ParseDetailed is Parse that also explains how the input was read: every
+// heuristic applied, such as "Obadiah 5" becoming verse 5 of chapter 1, "end"
+// expanding to a verse or a book carried over from the previous reference, is
+// returned as a Note in input order. Results are not cached.
+func (p *BiblePassageParser) ParseDetailed(versesString string) ([]*BiblePassage, []Note, error) {
+	trace := &tracer{notes: []Note{}}
+	passages, err := p.parse(versesString, ParseContext{}, trace)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.SliceStable(trace.notes, func(i, j int) bool { return trace.notes[i].Start < trace.notes[j].Start })
+	return passages, trace.notes, nil
+}
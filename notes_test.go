@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDetailed(t *testing.T) {
+	p := NewBiblePassageParser()
+	cases := []struct {
+		in   string
+		want []Note
+	}{
+		{"John 3:16", []Note{}},
+		{"Obadiah v 5", []Note{{NoteSingleChapterBook, "Obadiah has only one chapter, so 5 is verse 5 of chapter 1", 10, 11}}},
+		{"Jude 1", []Note{{NoteSingleChapterBook, "1 is read as a chapter of Jude, which has only one chapter", 5, 6}}},
+		{"Obadiah 5", []Note{{NoteSingleChapterBook, "Obadiah has only one chapter, so 5 is verse 5 of chapter 1", 8, 9}}},
+		{"Jude 1-7", []Note{
+			{NoteSingleChapterBook, "1 is read as a chapter of Jude, which has only one chapter", 5, 6},
+			{NoteSingleChapterBook, "Jude has only one chapter, so 7 is verse 7 of chapter 1", 7, 8},
+		}},
+		{"John v 16", []Note{{NoteChapterOrVerse, "no chapter given for verse 16; chapter 1 is assumed", 0, 9}}},
+		{"Jn 3:16, 18", []Note{
+			{NoteBookName, `"Jn" is read as John`, 0, 2},
+			{NoteCarriedOver, "book John carried over from the previous reference", 9, 11},
+			{NoteChapterOrVerse, "18 is read as a verse, as the previous reference is a verse", 9, 11},
+		}},
+		{"John 3, 4", []Note{
+			{NoteCarriedOver, "book John carried over from the previous reference", 8, 9},
+			{NoteChapterOrVerse, "4 is read as a chapter, as the previous reference is a chapter", 8, 9},
+		}},
+		{"John 3:16-end", []Note{{NoteEnd, `"end" is verse 36 of John 3`, 10, 13}}},
+		{"John 1-end", []Note{{NoteEnd, `"end" is chapter 21 of John`, 7, 10}}},
+		{"John 3 16", []Note{{NoteRewrite, "16 is read as a verse of chapter 3", 7, 9}}},
+		{"John 3-4a", []Note{{NoteRewrite, `fragment "a" on chapter 4 is ignored`, 7, 9}}},
+		{"John 3:16ff", []Note{{NoteFollowing, `"ff" is read as up to John 3:36`, 9, 11}}},
+		{"Psalm 51:0", []Note{{NoteSuperscription, "verse 0 is read as the title of Psalm 51", 9, 10}}},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			want, err := p.Parse(c.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, notes, err := p.ParseDetailed(c.in)
+			if err != nil {
+				t.Fatalf("parse error for %q: %v", c.in, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("passages differ from Parse: %v, want %v", got, want)
+			}
+			if !reflect.DeepEqual(notes, c.want) {
+				t.Errorf("notes for %q\n got: %v\nwant: %v", c.in, notes, c.want)
+			}
+		})
+	}
+
+	if _, _, err := p.ParseDetailed("Bob 1"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestParse_SingleChapterBookNumbers(t *testing.T) {
+	p := NewBiblePassageParser()
+	cases := map[string]string{
+		"Obadiah 5":    "Obadiah 1:5",
+		"Obadiah 5-7":  "Obadiah 1:5-7",
+		"Obadiah 5, 7": "Obadiah 1:5; Obadiah 1:7",
+		"Jude 1":       "Jude",
+		"Jude 1-7":     "Jude 1:1-7",
+	}
+	for in, want := range cases {
+		if got, err := p.Normalise(in); err != nil || got != want {
+			t.Errorf("Normalise(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	// numbers past the last verse are still chapters, which do not exist
+	for _, in := range []string{"Jude 30", "Jude 1-30", "Genesis 1-60"} {
+		if _, err := p.Parse(in); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", in)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Ordinal returns the position of the verse among all verses of the books of its
+// parser, counting from 0 at Genesis 1:1. Unlike IntegerNotation the ordinals are
+// dense, so they index arrays and bitsets of verse data. With the built-in book
+// table Revelation 22:21 is 31102; under the KJV numbering, which has one verse
+// less in 3 John, it would be 31101. Fragments are ignored, and a Psalm title or a
+// whole chapter has the ordinal of its verse 1. A book not created by a parser
+// counts from its own first verse. Ordinal is -1 when the chapter or verse does not
+// exist.
+func (r *BibleReference) Ordinal() int {
+	return r.ordinal(r.firstVerse())
+}
+
+// endOrdinal is the ordinal of the verse r ends with, the last verse of a whole
+// chapter. A Psalm title ends before verse 1 of its psalm, so a passage ending
+// with one ends with the verse before, and a title alone has endOrdinal below
+// Ordinal.
+func (r *BibleReference) endOrdinal() int {
+	if r.Superscription {
+		if n := r.Ordinal(); n >= 0 {
+			return n - 1
+		}
+		return -1
+	}
+	return r.ordinal(r.lastVerse())
+}
+
+func (r *BibleReference) ordinal(verse int) int {
+	if r.Chapter < 1 || r.Chapter > r.Book.ChaptersInBook() {
+		return -1
+	}
+	if verse < 1 {
+		verse = 1
+	}
+	chapters, before := r.Book.layout()
+	if verse > chapters[r.Chapter-1] {
+		return -1
+	}
+	return r.Book.first + before[r.Chapter-1] + verse - 1
+}
+
+// ReferenceFromOrdinal returns the verse at an ordinal returned by Ordinal.
+func (p *BiblePassageParser) ReferenceFromOrdinal(n int) (*BibleReference, error) {
+	i := sort.Search(len(p.ordered), func(i int) bool {
+		b := p.ordered[i]
+		return b.first+b.VersesInBook() > n
+	})
+	if n < 0 || i == len(p.ordered) {
+		return nil, fmt.Errorf("verse ordinal %d is outside the Bible", n)
+	}
+	b := p.ordered[i]
+	chapter, verse, err := b.VerseAt(n - b.first)
+	if err != nil {
+		return nil, err
+	}
+	return &BibleReference{Book: b, Chapter: chapter, Verse: verse}, nil
+}
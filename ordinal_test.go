@@ -0,0 +1,112 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/gotedo/bible-chapter-verse-parser/data"
+)
+
+func TestOrdinal(t *testing.T) {
+	p := NewBiblePassageParser()
+	cases := []struct {
+		in   string
+		want int
+	}{
+		{"Genesis 1:1", 0},
+		{"Genesis 1:31", 30},
+		{"Genesis 2:1", 31},
+		{"Genesis 50:26", 1532},
+		{"Exodus 1:1", 1533},
+		{"Matthew 1:1", 23145},
+		{"Revelation 22:21", 31102},
+		{"John 3:16b", 26136},
+		{"John 3:16", 26136},
+		{"Psalm 51:title", 14692},
+		{"Psalm 51:1", 14692},
+		{"John 3", 26121},
+	}
+	for _, c := range cases {
+		ref := mustParse(t, p, c.in)[0].From
+		if got := ref.Ordinal(); got != c.want {
+			t.Errorf("Ordinal(%s) = %d, want %d", c.in, got, c.want)
+		}
+	}
+
+	last := p.Versification().Verses - 1
+	for _, n := range []int{0, 1532, 1533, 14692, 23145, 26136, last} {
+		ref, err := p.ReferenceFromOrdinal(n)
+		if err != nil {
+			t.Fatalf("ReferenceFromOrdinal(%d): %v", n, err)
+		}
+		if got := ref.Ordinal(); got != n {
+			t.Errorf("ReferenceFromOrdinal(%d) = %s with ordinal %d", n, ref, got)
+		}
+	}
+	for _, n := range []int{-1, last + 1} {
+		if _, err := p.ReferenceFromOrdinal(n); err == nil {
+			t.Errorf("ReferenceFromOrdinal(%d) succeeded, want error", n)
+		}
+	}
+
+	// a whole chapter, as verse 0, starts with verse 1 and ends with its last verse
+	rom, _ := p.Book("Romans")
+	chapter, _ := NewBibleReference(rom, 1, 0, "")
+	first, _ := NewBibleReference(rom, 1, 1, "")
+	end, _ := NewBibleReference(rom, 1, 32, "")
+	if chapter.Ordinal() != first.Ordinal() || chapter.endOrdinal() != end.Ordinal() {
+		t.Errorf("Romans 1 has ordinals %d-%d, want %d-%d", chapter.Ordinal(), chapter.endOrdinal(), first.Ordinal(), end.Ordinal())
+	}
+	title := mustParse(t, p, "Psalm 51:title")[0].From
+	if title.endOrdinal() != title.Ordinal()-1 {
+		t.Errorf("Psalm 51:title ends at %d, want before %d", title.endOrdinal(), title.Ordinal())
+	}
+
+	ruth, _ := p.Book("Ruth")
+	for _, ref := range []*BibleReference{
+		{Book: ruth, Chapter: 1, Verse: 40},
+		{Book: ruth, Chapter: 1, Verse: 23},
+		{Book: ruth, Chapter: 5, Verse: 1},
+		{Book: ruth, Chapter: 0, Verse: 1},
+	} {
+		if got := ref.Ordinal(); got != -1 {
+			t.Errorf("Ordinal(%s) = %d, want -1", ref, got)
+		}
+	}
+}
+
+func TestOrdinal_RoundTrip(t *testing.T) {
+	p := NewBiblePassageParser()
+	prev := -1
+	for _, b := range p.Books() {
+		for ch := 1; ch <= b.ChaptersInBook(); ch++ {
+			vmax, _ := b.VersesInChapter(ch)
+			for v := 1; v <= vmax; v++ {
+				ref := &BibleReference{Book: b, Chapter: ch, Verse: v}
+				n := ref.Ordinal()
+				if n != prev+1 {
+					t.Fatalf("Ordinal(%s) = %d after %d", ref, n, prev)
+				}
+				back, err := p.ReferenceFromOrdinal(n)
+				if err != nil || back.Book != b || back.Chapter != ch || back.Verse != v {
+					t.Fatalf("ReferenceFromOrdinal(%d) = %v, %v; want %s", n, back, err, ref)
+				}
+				prev = n
+			}
+		}
+	}
+}
+
+func TestOrdinal_KJV(t *testing.T) {
+	structure := map[int]data.BookData{}
+	for num, bd := range data.BibleStructure {
+		structure[num] = bd
+	}
+	thirdJohn := structure[64]
+	thirdJohn.ChapterStructure = map[int]int{1: 14}
+	structure[64] = thirdJohn
+
+	p := NewBiblePassageParser(WithStructure(structure))
+	if got := mustParse(t, p, "Revelation 22:21")[0].From.Ordinal(); got != 31101 {
+		t.Errorf("KJV Ordinal(Revelation 22:21) = %d, want 31101", got)
+	}
+}
@@ -1,79 +1,310 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
-	"strconv"
+	"sort"
 	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/gotedo/bible-chapter-verse-parser/data"
 )
 
 var defaultSeparators = []string{"&", ",", ";", "and"}
 
+var defaultFragments = []rune{'a', 'b', 'c'}
+
+// refPart is a chapter or verse: a number with an optional fragment, or one of the
+// "end" and "start" keywords. kind is TokenInvalid when the part is absent.
+type refPart struct {
+	kind       TokenKind
+	value      int
+	fragment   string
+	start, end int
+}
+
+func (r refPart) present() bool {
+	return r.kind != TokenInvalid
+}
+
+// referenceMatch holds the parts of a single reference (one side of a range).
+type referenceMatch struct {
+	book           *Book
+	bookToken      Token
+	chapterOrVerse refPart
+	verse          refPart
+	// explicitVerse records that the text used 'v' or 'verse' directly after the book.
+	explicitVerse bool
+	// following is "f" or "ff" when the reference ends with the following-verses notation.
+	following  Token
+	start, end int
+}
+
+// Option configures a BiblePassageParser.
+type Option func(*BiblePassageParser)
+
+// WithCache keeps the results of up to size distinct inputs in a least-recently-used
+// cache. Cached passages are copied on the way in and out. A size of zero or less
+// disables the cache.
+func WithCache(size int) Option {
+	return func(p *BiblePassageParser) {
+		if size > 0 {
+			p.cache = newParseCache(size)
+		}
+	}
+}
+
+// WithFollowing makes "ff" cover the n following verses ("John 3:16ff" is John
+// 3:16-16+n), or the n following chapters after a chapter. By default "ff" runs to
+// the end of the chapter (or book). n must be positive.
+func WithFollowing(n int) Option {
+	return func(p *BiblePassageParser) {
+		if n > 0 {
+			p.following = n
+		}
+	}
+}
+
+// WithFragments replaces the fragment alphabet, which is a, b and c by default,
+// e.g. WithFragments("abcde") for lectionaries or WithFragments("αβγ"). Each letter
+// is one fragment and matching ignores case. Fragments are ordered by code point,
+// so "16a" comes before "16b" and "16α" before "16β". A letter in the alphabet is
+// always read as a fragment, so including "f" disables "16f" as following verses.
+func WithFragments(letters string) Option {
+	return func(p *BiblePassageParser) {
+		fragments := []rune{}
+		for _, r := range strings.ToLower(letters) {
+			if unicode.IsLetter(r) {
+				fragments = append(fragments, r)
+			}
+		}
+		if len(fragments) > 0 {
+			sort.Slice(fragments, func(i, j int) bool { return fragments[i] < fragments[j] })
+			p.fragments = fragments
+		}
+	}
+}
+
+// WithStrict rejects input that the parser would otherwise have to interpret:
+// chapters of single-chapter books given without a verse ("Jude 5": chapter or
+// verse?), verses separated from their chapter by a space ("John 3 16"), fragments
+// on chapters, "f" or "ff" running past the end of a chapter or book, ranges
+// without an end ("John 3:16 -") and a bare number after a verse and ";" ("Gen
+// 1:1; 2"), where ";" suggests a chapter. After "," such a number is a verse by the
+// usual convention ("John 3:16, 18") and is accepted. Every reference is also
+// checked against the book structure.
+func WithStrict() Option {
+	return func(p *BiblePassageParser) {
+		p.strict = true
+	}
+}
+
+// BiblePassageParser is safe for concurrent use by multiple goroutines; a single
+// parser is meant to be shared. Books are read-only once the parser is built, and
+// the state that can change afterwards (abbreviations added with AddAbbreviation
+// and the lazily built extraction pattern) is guarded by mu.
 type BiblePassageParser struct {
 	separators []string
-	books      map[int]*Book
-	bookAbbr   map[string]int
+	fragments  []rune
+	// structure is the book table the books are built from, set with WithStructure.
+	structure map[int]data.BookData
+	books     map[int]*Book
+	// ordered holds the books in order, for ReferenceFromOrdinal.
+	ordered []*Book
+
+	mu           sync.RWMutex
+	bookAbbr     map[string]int
+	maxBookWords int
+	extractRegex *regexp.Regexp
+
+	// contextRegex finds the bookless references ("v16", "4:1-5") of ExtractWithContext.
+	contextRegex *regexp.Regexp
+
+	cache *parseCache
+
+	// following is how many verses (or chapters) "ff" adds; zero runs to the end.
+	following int
+	strict    bool
 }
 
-func NewBiblePassageParser() *BiblePassageParser {
-	p := &BiblePassageParser{separators: defaultSeparators, books: map[int]*Book{}, bookAbbr: map[string]int{}}
-	for num, bd := range data.BibleStructure {
+func NewBiblePassageParser(opts ...Option) *BiblePassageParser {
+	p := &BiblePassageParser{separators: defaultSeparators, fragments: defaultFragments, structure: data.BibleStructure, books: map[int]*Book{}, bookAbbr: map[string]int{}}
+	for _, opt := range opts {
+		opt(p)
+	}
+	for num, bd := range p.structure {
 		b := NewBook(num, bd.Name, bd.SingularName, bd.Abbreviations, bd.ChapterStructure)
+		b.fragments = p.fragments
 		p.books[num] = b
-		p.bookAbbr[StandardiseString(b.Name)] = num
+		p.addAbbreviation(b.Name, num)
 		for _, a := range b.Abbreviations {
-			p.bookAbbr[StandardiseString(a)] = num
+			p.addAbbreviation(a, num)
+		}
+	}
+	for num, b := range p.books {
+		b.next = p.books[num+1]
+	}
+	if psalms, err := p.getBookFromAbbreviation("Psalms"); err == nil {
+		for _, b := range p.books {
+			b.psalms = b == psalms
+		}
+	}
+	p.ordered = p.Books()
+	for i, b := range p.ordered {
+		if i > 0 {
+			b.first = p.ordered[i-1].first + p.ordered[i-1].VersesInBook()
 		}
 	}
+	p.contextRegex = buildContextRegex(p.fragments)
 	return p
 }
 
+// NewCheckedBiblePassageParser is NewBiblePassageParser that also checks the
+// parser's book table with Validate, e.g. one given with WithStructure, and
+// returns the problems instead of a parser.
+func NewCheckedBiblePassageParser(opts ...Option) (*BiblePassageParser, error) {
+	p := NewBiblePassageParser(opts...)
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// CacheStats returns the hit and miss counters of the cache enabled with WithCache,
+// or zero values when the parser has no cache.
+func (p *BiblePassageParser) CacheStats() CacheStats {
+	if p.cache == nil {
+		return CacheStats{}
+	}
+	return p.cache.stats()
+}
+
+// AddAbbreviation registers an extra name for a book, e.g. AddAbbreviation("Jn", "John").
+// It may be called while other goroutines are parsing.
+func (p *BiblePassageParser) AddAbbreviation(abbreviation, book string) error {
+	b, err := p.getBookFromAbbreviation(book)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.checkAbbreviation(abbreviation, b.Number, p.bookAbbr); err != nil {
+		return err
+	}
+	p.addAbbreviation(abbreviation, b.Number)
+	p.extractRegex = nil
+	if p.cache != nil {
+		p.cache.purge()
+	}
+	return nil
+}
+
+// checkAbbreviation reports why abbreviation cannot name the book numbered book,
+// given the standardised names already taken in names. The lexer only recognises
+// book names that end with a letter, and it matches book names before keywords and
+// separators, so a book named "v" or "and" would hide them.
+func (p *BiblePassageParser) checkAbbreviation(abbreviation string, book int, names map[string]int) error {
+	s := StandardiseString(abbreviation)
+	if last, _ := utf8.DecodeLastRuneInString(s); !unicode.IsLetter(last) {
+		return fmt.Errorf("invalid abbreviation \"%s\": it must end with a letter", abbreviation)
+	}
+	if _, ok := keywords[s]; ok || p.isSeparator(s) {
+		return fmt.Errorf("invalid abbreviation \"%s\": it is a keyword", abbreviation)
+	}
+	if existing, ok := names[s]; ok && existing != book {
+		return fmt.Errorf("abbreviation \"%s\" already refers to %s", abbreviation, p.books[existing].Name)
+	}
+	return nil
+}
+
+// addAbbreviation must be called with mu held or before the parser is shared.
+func (p *BiblePassageParser) addAbbreviation(abbreviation string, book int) {
+	s := StandardiseString(abbreviation)
+	p.bookAbbr[s] = book
+	if n := len(strings.Fields(s)); n > p.maxBookWords {
+		p.maxBookWords = n
+	}
+}
+
+// Parse reads a list of passages such as "John 3:16-18, 19-21 & Psalm 23". Errors
+// are returned as *ParseError, which locates the offending part of the input.
 func (p *BiblePassageParser) Parse(versesString string) ([]*BiblePassage, error) {
-	if strings.TrimSpace(versesString) == "" {
-		return nil, fmt.Errorf("unable to parse reference")
+	if p.cache == nil {
+		return p.parse(versesString, ParseContext{}, nil)
 	}
-	substitutions := []struct{ re, rep string }{
-		// insert spaces between letters and digits and vice versa to normalize inputs like 'chapter3verse16'
-		{`(?i)([A-Za-z])([0-9])`, `$1 $2`},
-		// avoid splitting numeric+fragment (e.g. 15a). only split when the following letter is not a/b/c
-		{`(?i)([0-9])([d-z])`, `$1 $2`},
-		{`(?i)(—|–)`, `-`},
-		{`(?i)[^a-z]to[^a-z]`, `-`},
-		{`(?i)([^a-z])chapter([^a-z])`, `$1ch$2`},
-		{`(?i)([^a-z])c([^a-z])`, `$1ch$2`},
-		{`(?i)([^a-z])verses?([^a-z])`, `$1 v $2`},
-		{`(?i)(^|;\ *|\-)([\d])([a-zA-Z])`, `$1 $2 $3`},
+	if passages, ok := p.cache.get(versesString); ok {
+		return passages, nil
 	}
-	for _, s := range substitutions {
-		r := regexp.MustCompile(s.re)
-		versesString = r.ReplaceAllString(versesString, s.rep)
+	passages, err := p.parse(versesString, ParseContext{}, nil)
+	if err != nil {
+		return nil, err
 	}
+	p.cache.put(versesString, passages)
+	return passages, nil
+}
 
-	sections := SplitOnSeparators(p.separators, versesString)
+// parse reads versesString as if it followed the reference described by ctx. The
+// decisions it makes on the way are recorded in trace, which may be nil.
+func (p *BiblePassageParser) parse(versesString string, ctx ParseContext, trace *tracer) ([]*BiblePassage, error) {
+	if strings.TrimSpace(versesString) == "" {
+		return nil, &ParseError{Input: versesString, Start: 0, End: len(versesString), Err: errors.New("unable to parse reference")}
+	}
+	tokens, err := p.Tokenize(versesString)
+	if err != nil {
+		return nil, err
+	}
 
 	passages := []*BiblePassage{}
-	lastBook := ""
-	var lastChapter *int
-	var lastVerse *int
+	lastBook, lastChapter, lastVerse := ctx.state()
 
-	for _, section := range sections {
-		section = strings.TrimSpace(section)
-		if section == "" {
+	separators := []Token{}
+	for _, t := range tokens {
+		if t.Kind == TokenSeparator {
+			separators = append(separators, t)
+		}
+	}
+
+	for i, section := range splitTokens(tokens, TokenSeparator) {
+		if len(section) == 0 {
 			continue
 		}
+		sectionStart, sectionEnd := section[0].Start, section[len(section)-1].End
+		fail := func(err error) error {
+			return &ParseError{Input: versesString, Start: sectionStart, End: sectionEnd, Err: err}
+		}
 
-		splitSection := strings.Split(section, "-")
+		splitSection := splitTokens(section, TokenRange)
 		if len(splitSection) > 2 {
-			return nil, fmt.Errorf("Range is too complex")
+			return nil, fail(errors.New("Range is too complex"))
 		}
 
-		fromReference, startVerse, lb, lc, lv, lastFragment, err := p.parseStartReference(splitSection[0], lastBook, lastChapter, lastVerse)
+		startMatch, err := p.parseReference(versesString, splitSection[0], trace)
 		if err != nil {
 			return nil, err
 		}
 
+		if startMatch.book == nil && lastBook != nil {
+			from := "the previous reference"
+			if len(passages) == 0 {
+				from = "the context"
+			}
+			trace.add(NoteCarriedOver, startMatch.start, startMatch.end, "book %s carried over from %s", lastBook.Name, from)
+		}
+		if p.strict && i > 0 && separators[i-1].Text == ";" && startMatch.book == nil && lastVerse != nil && lastChapter != nil &&
+			startMatch.chapterOrVerse.kind == TokenNumber && !startMatch.verse.present() && !startMatch.explicitVerse {
+			// "Gen 1:1; 2": ";" usually starts a new chapter, but a number after a
+			// verse is read as a verse
+			n := startMatch.chapterOrVerse.value
+			return nil, fail(fmt.Errorf("%d after \";\" is ambiguous: write %d:%d for a verse or %s %d for the chapter", n, *lastChapter, n, lastBook.Name, n))
+		}
+		fromReference, startVerse, lb, lc, lv, lastFragment, err := p.parseStartReference(startMatch, lastBook, lastChapter, lastVerse, trace)
+		if err != nil {
+			return nil, fail(err)
+		}
+
 		lastBook = lb
 		lastChapter = lc
 		lastVerse = lv
@@ -81,13 +312,17 @@ func (p *BiblePassageParser) Parse(versesString string) ([]*BiblePassage, error)
 		var toReference *BibleReference
 
 		if len(splitSection) == 1 {
-			endBookObject, err := p.getBookFromAbbreviation(lastBook)
-			if err != nil {
-				return nil, err
-			}
+			endBookObject := lastBook
 			// if the parsed start contained an explicit verse (startVerse != nil), then
 			// the range is that single verse. Otherwise default to whole chapter/end as before.
-			if startVerse != nil {
+			if startMatch.following.Kind == TokenFollowing {
+				tr, err := p.followingReference(fromReference, startVerse != nil, strings.ToLower(startMatch.following.Text))
+				if err != nil {
+					return nil, fail(err)
+				}
+				toReference = tr
+				trace.add(NoteFollowing, startMatch.following.Start, startMatch.following.End, "%q is read as up to %s", startMatch.following.Text, tr)
+			} else if startVerse != nil {
 				toReference = fromReference
 			} else {
 				endChapterForReference := 0
@@ -107,92 +342,83 @@ func (p *BiblePassageParser) Parse(versesString string) ([]*BiblePassage, error)
 
 				tr, err := NewBibleReference(endBookObject, endChapterForReference, endVerse, lastFragment)
 				if err != nil {
-					return nil, err
+					return nil, fail(err)
 				}
 				toReference = tr
 			}
 		} else {
-			matches, err := p.parseReference(splitSection[1])
+			if p.strict && len(splitSection[1]) == 0 {
+				// "John 3:16 -" would run to the end of the chapter
+				return nil, fail(errors.New("range has no end"))
+			}
+			matches, err := p.parseReference(versesString, splitSection[1], trace)
 			if err != nil {
 				return nil, err
 			}
+			for _, f := range []Token{startMatch.following, matches.following} {
+				if f.Kind == TokenFollowing {
+					return nil, &ParseError{Input: versesString, Start: f.Start, End: f.End, Err: fmt.Errorf("%q cannot be used in a range", f.Text)}
+				}
+			}
 
-			endBook := ""
 			var endChapter *int
 			var endVerse *int
 			endFragment := ""
 
-			if matches["book"] != "" {
-				endBook = matches["book"]
-			}
-
-			endBookObject, err := p.getBookFromAbbreviation(func() string {
-				if endBook != "" {
-					return endBook
-				}
-				return lastBook
-			}())
-			if err != nil {
-				return nil, err
+			endBookObject := lastBook
+			if matches.book != nil {
+				endBookObject = matches.book
 			}
+			// "Genesis 49:3 - Exodus 2" ends with a chapter: a verse is only carried
+			// over within a book
+			crossBook := endBookObject != fromReference.Book
 
-			// (explicit verse marker handled in parseStartReference; nothing to do here)
-
-			if matches["chapter_or_verse"] != "" {
-				if startVerse != nil && matches["verse"] == "" {
+			if matches.chapterOrVerse.present() {
+				if startVerse != nil && !matches.verse.present() && !crossBook {
 					// this is an end verse
-					if matches["chapter_or_verse"] == "end" {
+					if matches.chapterOrVerse.kind == TokenEnd {
 						v, _ := endBookObject.VersesInChapter(*lastChapter)
 						ev := v
 						endVerse = &ev
+						trace.add(NoteEnd, matches.chapterOrVerse.start, matches.chapterOrVerse.end, "\"end\" is verse %d of %s %d", v, endBookObject.SingularName, *lastChapter)
 					} else {
-						s := matches["chapter_or_verse"]
-						if len(s) > 0 {
-							last := s[len(s)-1]
-							if last == 'a' || last == 'b' || last == 'c' || last == 'A' || last == 'B' || last == 'C' {
-								endFragment = strings.ToLower(string(last))
-							}
-						}
-						// strip trailing fragment letters before atoi
-						numStr := strings.TrimRightFunc(matches["chapter_or_verse"], func(r rune) bool {
-							return r == 'a' || r == 'b' || r == 'c' || r == 'A' || r == 'B' || r == 'C'
-						})
-						vi, _ := strconv.Atoi(numStr)
+						vi := matches.chapterOrVerse.value
 						endVerse = &vi
+						endFragment = matches.chapterOrVerse.fragment
 					}
 				} else {
-					if matches["chapter_or_verse"] == "end" {
+					if matches.chapterOrVerse.kind == TokenEnd {
 						ec := endBookObject.ChaptersInBook()
 						endChapter = &ec
+						trace.add(NoteEnd, matches.chapterOrVerse.start, matches.chapterOrVerse.end, "\"end\" is chapter %d of %s", ec, endBookObject.Name)
 					} else {
-						ciStr := matches["chapter_or_verse"]
-						// strip trailing fragment if present
-						ciNumStr := strings.TrimRightFunc(ciStr, func(r rune) bool {
-							return r == 'a' || r == 'b' || r == 'c' || r == 'A' || r == 'B' || r == 'C'
-						})
-						ci, _ := strconv.Atoi(ciNumStr)
+						// a fragment on an end chapter is meaningless and dropped
+						if matches.chapterOrVerse.fragment != "" {
+							if p.strict {
+								return nil, fail(fmt.Errorf("fragment %q on chapter %d", matches.chapterOrVerse.fragment, matches.chapterOrVerse.value))
+							}
+							trace.add(NoteRewrite, matches.chapterOrVerse.start, matches.chapterOrVerse.end, "fragment %q on chapter %d is ignored", matches.chapterOrVerse.fragment, matches.chapterOrVerse.value)
+						}
+						ci := matches.chapterOrVerse.value
 						endChapter = &ci
 					}
 				}
 			}
 
-			if matches["verse"] != "" {
-				// parse verse with optional fragment
-				vs := matches["verse"]
-				if len(vs) > 0 {
-					last := vs[len(vs)-1]
-					if last == 'a' || last == 'b' || last == 'c' || last == 'A' || last == 'B' || last == 'C' {
-						endFragment = strings.ToLower(string(last))
-					}
-				}
-				numStr := strings.TrimRightFunc(matches["verse"], func(r rune) bool { return r == 'a' || r == 'b' || r == 'c' || r == 'A' || r == 'B' || r == 'C' })
-				vi, _ := strconv.Atoi(numStr)
+			if matches.verse.present() {
+				vi := matches.verse.value
 				endVerse = &vi
+				if matches.verse.fragment != "" {
+					endFragment = matches.verse.fragment
+				}
 			}
 
 			endChapterForReference := 0
 			if endChapter != nil {
 				endChapterForReference = *endChapter
+			} else if matches.book != nil && endVerse == nil {
+				// "Genesis 3 - Exodus" runs to the end of the named book
+				endChapterForReference = endBookObject.ChaptersInBook()
 			} else if lastChapter != nil {
 				endChapterForReference = *lastChapter
 			} else {
@@ -200,27 +426,47 @@ func (p *BiblePassageParser) Parse(versesString string) ([]*BiblePassage, error)
 			}
 
 			// set last values
-			lastBook = endBookObject.NameFn()
+			lastBook = endBookObject
 			if endChapter != nil {
 				lastChapter = endChapter
 			}
 			lastVerse = endVerse
 
-			tr, err := NewBibleReference(endBookObject, endChapterForReference, func() int {
+			var tr *BibleReference
+			if endVerse != nil && *endVerse == 0 {
+				tr, err = NewSuperscriptionReference(endBookObject, endChapterForReference)
+			} else {
+				v, verr := endBookObject.VersesInChapter(endChapterForReference)
+				if verr != nil && endVerse == nil && endChapter != nil && !crossBook && endBookObject.ChaptersInBook() == 1 {
+					// "Jude 1-7": a single-chapter book has no chapter 7, so it is verse 7
+					trace.add(NoteSingleChapterBook, matches.chapterOrVerse.start, matches.chapterOrVerse.end, "%s has only one chapter, so %d is verse %d of chapter 1", endBookObject.Name, *endChapter, *endChapter)
+					endVerse, endChapterForReference = endChapter, 1
+					one := 1
+					lastChapter, lastVerse = &one, endVerse
+				}
 				if endVerse != nil {
-					return *endVerse
+					v = *endVerse
+				} else if verr != nil {
+					// "Genesis 1-60", "Romans 3 - Jude 5": the end chapter does not exist
+					return nil, fail(verr)
 				}
-				v, _ := endBookObject.VersesInChapter(endChapterForReference)
-				return v
-			}(), endFragment)
+				tr, err = NewBibleReference(endBookObject, endChapterForReference, v, endFragment)
+			}
 			if err != nil {
-				return nil, err
+				return nil, fail(err)
 			}
 			toReference = tr
 		}
 
-		if fromReference.IntegerNotation() > toReference.IntegerNotation() {
-			return nil, fmt.Errorf("references end is before beginning")
+		if p.strict {
+			for _, ref := range []*BibleReference{fromReference, toReference} {
+				if err := checkReference(ref); err != nil {
+					return nil, fail(err)
+				}
+			}
+		}
+		if endsBeforeStart(fromReference, toReference) {
+			return nil, fail(errEndBeforeStart)
 		}
 
 		passages = append(passages, NewBiblePassage(fromReference, toReference))
@@ -229,102 +475,122 @@ func (p *BiblePassageParser) Parse(versesString string) ([]*BiblePassage, error)
 	return passages, nil
 }
 
-func (p *BiblePassageParser) parseStartReference(textReference, lastBook string, lastChapter, lastVerse *int) (*BibleReference, *int, string, *int, *int, string, error) {
-	matches, err := p.parseReference(textReference)
-	if err != nil {
-		return nil, nil, "", nil, nil, "", err
-	}
-
-	book := ""
+func (p *BiblePassageParser) parseStartReference(matches referenceMatch, lastBook *Book, lastChapter, lastVerse *int, trace *tracer) (*BibleReference, *int, *Book, *int, *int, string, error) {
 	var chapter *int
 	var verse *int
 	fragment := ""
 
-	if matches["book"] != "" {
-		book = matches["book"]
+	startBookObject := lastBook
+	if matches.book != nil {
+		startBookObject = matches.book
 		lastChapter = nil
 		lastVerse = nil
-	} else {
-		book = lastBook
+	}
+	if startBookObject == nil {
+		return nil, nil, nil, nil, nil, "", fmt.Errorf("invalid book name \"\"")
 	}
 
-	// get start book object early so we can make decisions for single-chapter books
-	startBookObject, err := p.getBookFromAbbreviation(book)
-	if err != nil {
-		return nil, nil, "", nil, nil, "", err
+	if matches.book == nil && matches.explicitVerse {
+		// "v16" without a book is a verse of the current chapter
+		if lastChapter == nil || *lastChapter <= 0 {
+			return nil, nil, nil, nil, nil, "", fmt.Errorf("verse %d has no chapter", matches.verse.value)
+		}
+		vi := matches.verse.value
+		var fromRef *BibleReference
+		var err error
+		if vi == 0 {
+			fromRef, err = NewSuperscriptionReference(startBookObject, *lastChapter)
+		} else {
+			fromRef, err = NewBibleReference(startBookObject, *lastChapter, vi, matches.verse.fragment)
+		}
+		if err != nil {
+			return nil, nil, nil, nil, nil, "", err
+		}
+		return fromRef, &vi, startBookObject, lastChapter, &vi, matches.verse.fragment, nil
 	}
 
-	// detect whether parseReference marked an explicit verse indicator (e.g. 'v' or 'verse')
-	explicitVerse := false
-	if strings.HasSuffix(matches["verse"], "__explicitverse") {
-		explicitVerse = true
-		matches["verse"] = strings.TrimSuffix(matches["verse"], "__explicitverse")
+	if p.strict && startBookObject.ChaptersInBook() == 1 && matches.chapterOrVerse.kind == TokenNumber && !matches.verse.present() && !matches.explicitVerse && lastVerse == nil {
+		n := matches.chapterOrVerse.value
+		return nil, nil, nil, nil, nil, "", fmt.Errorf("%s %d is ambiguous: write %s 1:%d for a verse or %s for the book", startBookObject.Name, n, startBookObject.Name, n, startBookObject.Name)
 	}
 
-	if matches["chapter_or_verse"] != "" {
-		// If the book has only one chapter, prefer to treat the numeric token as a
-		// verse only when the original text explicitly indicated a verse (e.g. used
-		// 'v' or the word 'verse'). Otherwise treat it as a chapter (to match PHP behaviour).
-		if startBookObject.ChaptersInBook() == 1 && explicitVerse {
-			if matches["chapter_or_verse"] == "end" {
+	if matches.chapterOrVerse.present() {
+		// If the book has only one chapter, treat the numeric token as a verse when
+		// the original text explicitly indicated a verse (e.g. used 'v' or the word
+		// 'verse') or when it cannot be a chapter ("Obadiah 5"). "Jude 1" stays
+		// the chapter, as in PHP.
+		bareVerse := startBookObject.ChaptersInBook() == 1 && !matches.explicitVerse && !matches.verse.present() &&
+			lastVerse == nil && matches.chapterOrVerse.kind == TokenNumber && matches.chapterOrVerse.value > 1
+		if startBookObject.ChaptersInBook() == 1 && (matches.explicitVerse || bareVerse) {
+			if matches.chapterOrVerse.kind == TokenEnd {
 				ci := -1
 				chapter = &ci
 			} else {
-				ci, frag := parseNumFragment(matches["chapter_or_verse"])
+				ci, frag := matches.chapterOrVerse.value, matches.chapterOrVerse.fragment
 				// if numeric token looks like a verse, use it
 				vMax, _ := startBookObject.VersesInChapter(1)
 				if ci > 0 && ci <= vMax {
 					verse = &ci
-					if frag != "" {
-						fragment = frag
+					if bareVerse {
+						trace.add(NoteSingleChapterBook, matches.chapterOrVerse.start, matches.chapterOrVerse.end, "%s has only one chapter, so %d is verse %d of chapter 1", startBookObject.Name, ci, ci)
 					}
 				} else {
 					chapter = &ci
-					if frag != "" {
-						fragment = frag
-					}
+				}
+				if frag != "" {
+					fragment = frag
 				}
 			}
 			lastVerse = nil
-		} else if lastVerse == nil || matches["verse"] != "" {
+		} else if lastVerse == nil || matches.verse.present() {
 			// chapter
-			if matches["chapter_or_verse"] == "end" {
+			if matches.chapterOrVerse.kind == TokenEnd {
 				ci := -1
 				chapter = &ci
 			} else {
-				ci, frag := parseNumFragment(matches["chapter_or_verse"])
+				ci := matches.chapterOrVerse.value
 				chapter = &ci
-				if frag != "" {
-					fragment = frag
+				if matches.explicitVerse {
+					trace.add(NoteChapterOrVerse, matches.start, matches.end, "no chapter given for verse %d; chapter 1 is assumed", matches.verse.value)
+				} else if !matches.verse.present() && startBookObject.ChaptersInBook() == 1 {
+					trace.add(NoteSingleChapterBook, matches.chapterOrVerse.start, matches.chapterOrVerse.end, "%d is read as a chapter of %s, which has only one chapter", ci, startBookObject.Name)
+				} else if !matches.verse.present() && matches.book == nil {
+					trace.add(NoteChapterOrVerse, matches.chapterOrVerse.start, matches.chapterOrVerse.end, "%d is read as a chapter, as the previous reference is a chapter", ci)
+				}
+				if matches.chapterOrVerse.fragment != "" {
+					if p.strict {
+						return nil, nil, nil, nil, nil, "", fmt.Errorf("fragment %q on chapter %d", matches.chapterOrVerse.fragment, ci)
+					}
+					fragment = matches.chapterOrVerse.fragment
 				}
 			}
 			lastVerse = nil
 		} else {
 			// verse
-			vi, frag := parseNumFragment(matches["chapter_or_verse"])
+			vi := matches.chapterOrVerse.value
 			verse = &vi
-			if frag != "" {
-				fragment = frag
+			trace.add(NoteChapterOrVerse, matches.chapterOrVerse.start, matches.chapterOrVerse.end, "%d is read as a verse, as the previous reference is a verse", vi)
+			if matches.chapterOrVerse.fragment != "" {
+				fragment = matches.chapterOrVerse.fragment
 			}
 		}
 	}
 
-	if matches["verse"] != "" {
+	if matches.verse.present() {
+		vi := matches.verse.value
 		// If chapter is nil and the book only has one chapter, treat this as a verse, not a chapter
 		if chapter == nil && startBookObject.ChaptersInBook() == 1 {
-			vi, frag := parseNumFragment(matches["verse"])
 			verse = &vi
-			if frag != "" {
-				fragment = frag
+			trace.add(NoteSingleChapterBook, matches.verse.start, matches.verse.end, "%s has only one chapter, so %d is verse %d of chapter 1", startBookObject.Name, vi, vi)
+			if matches.verse.fragment != "" {
+				fragment = matches.verse.fragment
 			}
 		} else if chapter == nil {
-			ci, _ := strconv.Atoi(matches["verse"])
-			chapter = &ci
+			chapter = &vi
 		} else {
-			vi, frag := parseNumFragment(matches["verse"])
 			verse = &vi
-			if frag != "" {
-				fragment = frag
+			if matches.verse.fragment != "" {
+				fragment = matches.verse.fragment
 			}
 		}
 	}
@@ -333,8 +599,6 @@ func (p *BiblePassageParser) parseStartReference(textReference, lastBook string,
 		chapter = lastChapter
 	}
 
-	lastBookName := startBookObject.NameFn()
-
 	ch := 1
 	if chapter != nil && *chapter > 0 {
 		ch = *chapter
@@ -344,78 +608,180 @@ func (p *BiblePassageParser) parseStartReference(textReference, lastBook string,
 		v = *verse
 	}
 
-	fromRef, err := NewBibleReference(startBookObject, ch, v, fragment)
+	var fromRef *BibleReference
+	var err error
+	if verse != nil && *verse == 0 {
+		// verse 0 ("Psalm 51:0" or "Psalm 51 title") is the superscription
+		fromRef, err = NewSuperscriptionReference(startBookObject, ch)
+		if err == nil && matches.verse.kind == TokenNumber {
+			trace.add(NoteSuperscription, matches.verse.start, matches.verse.end, "verse 0 is read as the title of %s %d", startBookObject.SingularName, ch)
+		}
+	} else {
+		fromRef, err = NewBibleReference(startBookObject, ch, v, fragment)
+	}
 	if err != nil {
-		return nil, nil, "", nil, nil, "", err
+		return nil, nil, nil, nil, nil, "", err
 	}
 
-	return fromRef, verse, lastBookName, chapter, verse, fragment, nil
+	return fromRef, verse, startBookObject, chapter, verse, fragment, nil
 }
 
-func (p *BiblePassageParser) parseReference(reference string) (map[string]string, error) {
-	reference = strings.ToLower(reference)
-	regex := regexp.MustCompile(`^\s*(?P<book>(?:[0-9]+\s+)?[^0-9]+)?(?:(?P<chapter_or_verse>[0-9]+[abc]?)?(?:\s*[\. \:v]+\s*(?P<verse>[0-9]+[abc]?(?:end)?))?)?\s*$`)
-	result := regex.FindStringSubmatch(reference)
-	if result == nil {
-		return nil, fmt.Errorf("unable to parse reference")
+// parseReference reads one side of a range following the grammar
+//
+//	reference := [book] [chapter-marker...] (part [verse-marker...] [number [fragment]]
+//	           | verse-marker... number [fragment]) [following]
+//	part      := number [fragment] | "end" | "start"
+//	following := "f" | "ff"
+//
+// where the second form ("Obadiah v 5", or "v 5" in the current chapter) marks an
+// explicit verse. The verse may also be "title" ("Psalm 51 title"), the
+// superscription of a Psalm.
+func (p *BiblePassageParser) parseReference(input string, tokens []Token, trace *tracer) (referenceMatch, error) {
+	matches := referenceMatch{}
+	if len(tokens) > 0 {
+		matches.start, matches.end = tokens[0].Start, tokens[len(tokens)-1].End
 	}
-	matches := map[string]string{"book": "", "chapter_or_verse": "", "verse": ""}
-	for i, name := range regex.SubexpNames() {
-		if i != 0 && name != "" {
-			matches[name] = strings.TrimSpace(result[i])
+	unexpected := func(t Token) error {
+		if t.Kind == TokenWord {
+			return &ParseError{Input: input, Start: t.Start, End: t.End, Err: fmt.Errorf("invalid book name \"%s\"", strings.ToLower(t.Text))}
 		}
+		return &ParseError{Input: input, Start: t.Start, End: t.End, Err: fmt.Errorf("unexpected %s %q", t.Kind, t.Text)}
 	}
 
-	if (matches["book"] == "start" || matches["book"] == "end") && matches["chapter_or_verse"] == "" && matches["verse"] == "" {
-		matches["chapter_or_verse"] = matches["book"]
-		matches["book"] = ""
-	}
-
-	// Remove trailing " ch" or " v" from book if present
-	if len(matches["book"]) >= 3 {
-		if strings.HasSuffix(matches["book"], " ch") {
-			trimmed := strings.TrimSpace(matches["book"][0 : len(matches["book"])-3])
-			if regexp.MustCompile(`[A-Za-z]+`).MatchString(trimmed) {
-				matches["book"] = trimmed
+	i := 0
+	if i < len(tokens) && tokens[i].Kind == TokenBook {
+		matches.book = p.books[tokens[i].Value]
+		matches.bookToken = tokens[i]
+		if trace != nil {
+			if name := StandardiseString(tokens[i].Text); name != StandardiseString(matches.book.Name) && name != StandardiseString(matches.book.SingularName) {
+				trace.add(NoteBookName, tokens[i].Start, tokens[i].End, "%q is read as %s", tokens[i].Text, matches.book.Name)
 			}
 		}
+		i++
+	}
+	for i < len(tokens) && tokens[i].Kind == TokenChapterMarker {
+		i++
 	}
 
-	if len(matches["book"]) >= 2 {
-		if strings.HasSuffix(matches["book"], " v") {
-			trimmed := strings.TrimSpace(matches["book"][0 : len(matches["book"])-2])
-			if regexp.MustCompile(`[A-Za-z]+`).MatchString(trimmed) {
-				matches["book"] = trimmed
-				if matches["verse"] == "" {
-					matches["verse"] = matches["chapter_or_verse"]
-					matches["chapter_or_verse"] = "1"
-					// mark that the original text explicitly used 'v' or 'verse'
-					matches["verse"] = matches["verse"] + "__explicitverse"
-				}
+	if i < len(tokens) && tokens[i].Kind == TokenVerseMarker && tokens[i].Text != ":" && tokens[i].Text != "." {
+		for i < len(tokens) && tokens[i].Kind == TokenVerseMarker {
+			i++
+		}
+		var ok bool
+		if matches.verse, i, ok = readPart(tokens, i); !ok || matches.verse.kind != TokenNumber {
+			if i < len(tokens) {
+				return matches, unexpected(tokens[i])
 			}
+			return matches, &ParseError{Input: input, Start: matches.start, End: matches.end, Err: errors.New("unable to parse reference")}
 		}
-		// also accept the full word 'verse' or 'verses' attached to the book
-		if strings.HasSuffix(matches["book"], " verse") || strings.HasSuffix(matches["book"], " verses") {
-			// remove the trailing word
-			parts := strings.Fields(matches["book"]) // split on whitespace
-			if len(parts) > 0 {
-				trimmed := strings.Join(parts[0:len(parts)-1], " ")
-				if regexp.MustCompile(`[A-Za-z]+`).MatchString(trimmed) {
-					matches["book"] = trimmed
-					if matches["verse"] == "" {
-						matches["verse"] = matches["chapter_or_verse"]
-						matches["chapter_or_verse"] = "1"
-						// mark explicit use of the word 'verse'
-						matches["verse"] = matches["verse"] + "__explicitverse"
-					}
+		// mark that the original text explicitly used 'v' or 'verse'
+		matches.chapterOrVerse = refPart{kind: TokenNumber, value: 1}
+		matches.explicitVerse = true
+	} else if part, next, ok := readPart(tokens, i); ok && part.kind != TokenTitle {
+		matches.chapterOrVerse = part
+		i = next
+		markers := 0
+		for i < len(tokens) && tokens[i].Kind == TokenVerseMarker {
+			markers++
+			i++
+		}
+		if i < len(tokens) && (tokens[i].Kind == TokenNumber || tokens[i].Kind == TokenTitle) {
+			if markers == 0 && tokens[i].Kind == TokenNumber {
+				if p.strict {
+					return matches, &ParseError{Input: input, Start: tokens[i].Start, End: tokens[i].End, Err: fmt.Errorf("verse %q must be separated from its chapter by ':' or 'v'", tokens[i].Text)}
 				}
+				trace.add(NoteRewrite, tokens[i].Start, tokens[i].End, "%s is read as a verse of chapter %d", tokens[i].Text, matches.chapterOrVerse.value)
 			}
+			matches.verse, i, _ = readPart(tokens, i)
+		} else if markers > 0 {
+			if i < len(tokens) {
+				return matches, unexpected(tokens[i])
+			}
+			return matches, &ParseError{Input: input, Start: matches.start, End: matches.end, Err: errors.New("unable to parse reference")}
 		}
 	}
 
+	if i < len(tokens) && tokens[i].Kind == TokenFollowing && matches.chapterOrVerse.kind == TokenNumber {
+		matches.following = tokens[i]
+		i++
+	}
+
+	if i < len(tokens) {
+		return matches, unexpected(tokens[i])
+	}
 	return matches, nil
 }
 
+// followingReference is the end of "16f" (the next verse) or "16ff" (the rest of
+// the chapter, or the configured number of verses). After a chapter rather than a
+// verse, "f" and "ff" count chapters instead.
+func (p *BiblePassageParser) followingReference(from *BibleReference, verses bool, following string) (*BibleReference, error) {
+	n := 1
+	if following == "ff" {
+		n = p.following
+	}
+	if verses {
+		last, err := from.Book.VersesInChapter(from.Chapter)
+		if err != nil {
+			return nil, err
+		}
+		if p.strict && (from.Verse == last || n > 0 && from.Verse+n > last) {
+			return nil, fmt.Errorf("%q runs past the end of %s %d", following, from.Book.SingularName, from.Chapter)
+		}
+		if n > 0 && from.Verse+n < last {
+			last = from.Verse + n
+		}
+		return NewBibleReference(from.Book, from.Chapter, last, "")
+	}
+	chapter := from.Book.ChaptersInBook()
+	if p.strict && (from.Chapter == chapter || n > 0 && from.Chapter+n > chapter) {
+		return nil, fmt.Errorf("%q runs past the end of %s", following, from.Book.Name)
+	}
+	if n > 0 && from.Chapter+n < chapter {
+		chapter = from.Chapter + n
+	}
+	last, err := from.Book.VersesInChapter(chapter)
+	if err != nil {
+		return nil, err
+	}
+	return NewBibleReference(from.Book, chapter, last, "")
+}
+
+// readPart reads a number with an optional fragment, or the "end", "start" or
+// "title" keyword.
+func readPart(tokens []Token, i int) (refPart, int, bool) {
+	if i >= len(tokens) {
+		return refPart{}, i, false
+	}
+	switch tokens[i].Kind {
+	case TokenEnd, TokenStart, TokenTitle:
+		return refPart{kind: tokens[i].Kind, start: tokens[i].Start, end: tokens[i].End}, i + 1, true
+	case TokenNumber:
+		part := refPart{kind: TokenNumber, value: tokens[i].Value, start: tokens[i].Start, end: tokens[i].End}
+		i++
+		if i < len(tokens) && tokens[i].Kind == TokenFragment {
+			part.fragment = strings.ToLower(tokens[i].Text)
+			part.end = tokens[i].End
+			i++
+		}
+		return part, i, true
+	}
+	return refPart{}, i, false
+}
+
+// splitTokens splits tokens around each token of the given kind.
+func splitTokens(tokens []Token, kind TokenKind) [][]Token {
+	parts := [][]Token{}
+	start := 0
+	for i, t := range tokens {
+		if t.Kind == kind {
+			parts = append(parts, tokens[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, tokens[start:])
+}
+
 func (p *BiblePassageParser) getBookFromAbbreviation(bookAbbreviation string) (*Book, error) {
 	bn, err := p.getBookNumber(bookAbbreviation)
 	if err != nil {
@@ -430,27 +796,39 @@ func (p *BiblePassageParser) getBookFromAbbreviation(bookAbbreviation string) (*
 
 func (p *BiblePassageParser) getBookNumber(bookAbbreviation string) (int, error) {
 	s := StandardiseString(bookAbbreviation)
+	p.mu.RLock()
+	defer p.mu.RUnlock()
 	if v, ok := p.bookAbbr[s]; ok {
 		return v, nil
 	}
 	return 0, fmt.Errorf("invalid book name \"%s\"", bookAbbreviation)
 }
 
-// parseNumFragment parses a string like "16b" or "36B" and returns the integer and the
-// optional fragment (a/b/c) in lowercase. If no fragment is present, fragment is empty.
-func parseNumFragment(s string) (int, string) {
-	if s == "" {
-		return 0, ""
+// Book returns the book matching a name or abbreviation, e.g. "1 Cor" or "Psalms".
+func (p *BiblePassageParser) Book(name string) (*Book, error) {
+	return p.getBookFromAbbreviation(name)
+}
+
+// Books returns every book known to the parser in canonical order.
+func (p *BiblePassageParser) Books() []*Book {
+	books := make([]*Book, 0, len(p.books))
+	for _, b := range p.books {
+		books = append(books, b)
 	}
-	last := s[len(s)-1]
-	frag := ""
-	if last == 'a' || last == 'b' || last == 'c' || last == 'A' || last == 'B' || last == 'C' {
-		frag = strings.ToLower(string(last))
-		s = s[:len(s)-1]
+	sort.Slice(books, func(i, j int) bool { return books[i].Number < books[j].Number })
+	return books
+}
+
+// Normalise parses versesString and returns the passages in their shorthand form,
+// joined with "; " (e.g. "jn 3v16-18 and ps 23" becomes "John 3:16-18; Psalm 23").
+func (p *BiblePassageParser) Normalise(versesString string) (string, error) {
+	passages, err := p.Parse(versesString)
+	if err != nil {
+		return "", err
 	}
-	if s == "" {
-		return 0, frag
+	parts := make([]string, len(passages))
+	for i, pass := range passages {
+		parts[i] = pass.String()
 	}
-	n, _ := strconv.Atoi(s)
-	return n, frag
+	return strings.Join(parts, "; "), nil
 }
@@ -1,6 +1,10 @@
 package parser
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
 
 type BiblePassage struct {
 	From *BibleReference
@@ -11,50 +15,108 @@ func NewBiblePassage(from, to *BibleReference) *BiblePassage {
 	return &BiblePassage{From: from, To: to}
 }
 
+// Passages sorts passages by where they start and then by where they end:
+//
+//	sort.Sort(parser.Passages(passages))
+type Passages []*BiblePassage
+
+func (ps Passages) Len() int      { return len(ps) }
+func (ps Passages) Swap(i, j int) { ps[i], ps[j] = ps[j], ps[i] }
+func (ps Passages) Less(i, j int) bool {
+	if c := ps[i].From.Compare(ps[j].From); c != 0 {
+		return c < 0
+	}
+	return ps[i].To.endPosition().compare(ps[j].To.endPosition()) < 0
+}
+
+var _ sort.Interface = Passages(nil)
+
+var errEndBeforeStart = errors.New("references end is before beginning")
+
+// Validate returns an error when the passage ends before it begins. A whole verse
+// ends after its fragments, so "John 3:16a-16" is valid.
+func (p *BiblePassage) Validate() error {
+	if endsBeforeStart(p.From, p.To) {
+		return errEndBeforeStart
+	}
+	return nil
+}
+
+// endsBeforeStart reports whether a range from from to to would be backwards. A
+// whole verse ends after its fragments, so "John 3:16a-16" is not backwards.
+func endsBeforeStart(from, to *BibleReference) bool {
+	return to.Less(from) && !(to.Fragment == "" && to.IntegerNotation() == from.IntegerNotation())
+}
+
+// FormatOptions adjusts the shorthand produced by Format.
+type FormatOptions struct {
+	// FF writes passages that run from a verse to the end of its chapter as
+	// "John 3:16ff" instead of "John 3:16-36".
+	FF bool
+}
+
 func (p *BiblePassage) String() string {
+	return p.Format(FormatOptions{})
+}
+
+// Format returns the shorthand form of the passage, like String, with the given options.
+func (p *BiblePassage) Format(opts FormatOptions) string {
 	from := p.From
 	to := p.To
+	if from.wholeChapter() || to.wholeChapter() {
+		// write the verses a whole chapter stands for: "John 3:0-4:5" is "John 3:1-4:5"
+		f, t := *from, *to
+		f.Verse, t.Verse = from.firstVerse(), to.lastVerse()
+		from, to = &f, &t
+	}
 	// Mirror the PHP formatting rules precisely.
-	// Check for entire book: from 1:1 to last chapter:last verse in same book
-	if to.Book == from.Book && from.Chapter == 1 && from.Verse == 1 {
-		if to.Book.ChaptersInBook() == to.Chapter {
-			if vmax, _ := to.Book.VersesInChapter(to.Chapter); vmax == to.Verse {
-				return from.Book.Name
-			}
-		}
+	if p.IsWholeBook() {
+		return from.Book.Name
 	}
 
 	trailer := fmt.Sprintf(" %d", from.Chapter)
 
 	// Format "John 3" or "Psalm 3"
-	if to.Book == from.Book && to.Chapter == from.Chapter && (from.Verse == 0 || (from.Verse == 1 && func() bool {
-		vmax, _ := to.Book.VersesInChapter(to.Chapter)
-		return vmax == to.Verse
-	}())) {
+	if p.IsWholeChapter() {
 		return from.Book.SingularName + trailer
 	}
 
-	trailer = trailer + fmt.Sprintf(":%d%s", from.Verse, from.Fragment)
+	trailer = trailer + ":" + from.verseLabel()
 
 	// Format "John 3:16"
-	if to.Book == from.Book && to.Chapter == from.Chapter && to.Verse == from.Verse {
+	if to.Book == from.Book && to.Chapter == from.Chapter && to.Verse == from.Verse && to.Superscription == from.Superscription && to.Fragment == from.Fragment {
 		return from.Book.SingularName + trailer
 	}
 
-	// Format "John 3:16-17"
-	if from.Chapter == to.Chapter {
-		return from.Book.SingularName + trailer + "-" + fmt.Sprintf("%d%s", to.Verse, to.Fragment)
+	// Format "John 3:16ff"
+	if opts.FF && to.Book == from.Book && from.Chapter == to.Chapter && to.Fragment == "" && !from.Superscription {
+		if vmax, _ := to.Book.VersesInChapter(to.Chapter); vmax == to.Verse {
+			return from.Book.SingularName + trailer + "ff"
+		}
 	}
 
-	toString := fmt.Sprintf("%d:%d%s", to.Chapter, to.Verse, to.Fragment)
+	// Format "John 3:16-17"
+	if from.Book == to.Book && from.Chapter == to.Chapter {
+		return from.Book.SingularName + trailer + "-" + to.verseLabel()
+	}
 
-	// Format cross-book: "John 3:16 - Acts 1:1" (note spaces around dash)
+	// Format cross-book: "John 3:16 - Acts 1:1" (note spaces around dash),
+	// collapsed to books ("Genesis - Deuteronomy") or chapters ("1 Kings 18 -
+	// 2 Kings 2") when both ends align with them
 	if from.Book != to.Book {
-		return from.Book.Name + trailer + " - " + to.Book.Name + " " + toString
+		if from.startsChapter() && to.endsChapter() {
+			if from.Chapter == 1 && to.Chapter == to.Book.ChaptersInBook() {
+				return from.Book.Name + " - " + to.Book.Name
+			}
+			return fmt.Sprintf("%s %d - %s %d", from.Book.Name, from.Chapter, to.Book.Name, to.Chapter)
+		}
+		return from.Book.Name + trailer + " - " + to.Book.Name + fmt.Sprintf(" %d:%s", to.Chapter, to.verseLabel())
 	}
 
+	toString := fmt.Sprintf("%d:%s", to.Chapter, to.verseLabel())
+
 	// Psalms plural case: "Psalms 120-134"
-	if from.Verse == 1 {
+	if from.Verse == 1 && !from.Superscription {
 		if vmax, _ := to.Book.VersesInChapter(to.Chapter); vmax == to.Verse {
 			return from.Book.Name + " " + fmt.Sprintf("%d-%d", from.Chapter, to.Chapter)
 		}
@@ -62,3 +124,144 @@ func (p *BiblePassage) String() string {
 
 	return from.Book.SingularName + trailer + "-" + toString
 }
+
+// IsWholeBook reports whether the passage is exactly one book, from 1:1 to its
+// last verse, without fragments.
+func (p *BiblePassage) IsWholeBook() bool {
+	from, to := p.From, p.To
+	return from.Book == to.Book && from.Chapter == 1 && from.startsChapter() &&
+		to.Chapter == to.Book.ChaptersInBook() && to.endsChapter()
+}
+
+// IsWholeChapter reports whether the passage is exactly one chapter, from verse 1
+// to its last verse, without fragments; either end may be the chapter itself, as
+// verse 0. A Psalm title is not part of the chapter, so "Psalm 51:title-19" is not
+// whole.
+func (p *BiblePassage) IsWholeChapter() bool {
+	from, to := p.From, p.To
+	return from.Book == to.Book && from.Chapter == to.Chapter && from.startsChapter() && to.endsChapter()
+}
+
+// startsChapter reports whether r starts with the whole first verse of its chapter.
+func (r *BibleReference) startsChapter() bool {
+	return r.firstVerse() == 1 && r.Fragment == "" && !r.Superscription
+}
+
+// endsChapter reports whether r ends with the whole last verse of its chapter.
+func (r *BibleReference) endsChapter() bool {
+	vmax, err := r.Book.VersesInChapter(r.Chapter)
+	return err == nil && r.lastVerse() == vmax && r.Fragment == "" && !r.Superscription
+}
+
+// Chapters splits the passage at chapter boundaries, returning one passage per
+// chapter it touches. The first and last keep the passage's ends, fragments and
+// Psalm titles included; a psalm the passage runs into starts with its title.
+func (p *BiblePassage) Chapters() []*BiblePassage {
+	return p.split(func(book *Book, chapter int) bool { return true })
+}
+
+// Books splits the passage at book boundaries, returning one passage per book it
+// touches.
+func (p *BiblePassage) Books() []*BiblePassage {
+	return p.split(func(book *Book, chapter int) bool { return chapter == book.ChaptersInBook() })
+}
+
+// split walks the chapters of the passage and cuts it after each chapter for which
+// cut returns true.
+func (p *BiblePassage) split(cut func(book *Book, chapter int) bool) []*BiblePassage {
+	out := []*BiblePassage{}
+	f := *p.From
+	from := &f
+	for book, chapter := p.From.Book, p.From.Chapter; book != nil; {
+		if book == p.To.Book && chapter == p.To.Chapter {
+			if p.To.Superscription && from.Book == book && from.Chapter == chapter && !from.Superscription {
+				// the chapter holds nothing of the passage but its title
+				from = &BibleReference{Book: book, Chapter: chapter, Superscription: true}
+			}
+			t := *p.To
+			return append(out, NewBiblePassage(from, &t))
+		}
+		if cut(book, chapter) {
+			vmax, _ := book.VersesInChapter(chapter)
+			out = append(out, NewBiblePassage(from, &BibleReference{Book: book, Chapter: chapter, Verse: vmax}))
+			from = nil
+		}
+		chapter++
+		if chapter > book.ChaptersInBook() {
+			book, chapter = book.next, 1
+		}
+		if from == nil && book != nil {
+			from = &BibleReference{Book: book, Chapter: chapter, Verse: 1}
+			if book.HasSuperscription(chapter) {
+				from = &BibleReference{Book: book, Chapter: chapter, Superscription: true}
+			}
+		}
+	}
+	return out
+}
+
+// ExpandToChapters returns the passage widened to whole chapters: from verse 1 of
+// its first chapter (or its Psalm title, when it starts with one) to the last
+// verse of its last chapter.
+func (p *BiblePassage) ExpandToChapters() *BiblePassage {
+	from := &BibleReference{Book: p.From.Book, Chapter: p.From.Chapter, Verse: 1}
+	if p.From.Superscription {
+		from = &BibleReference{Book: p.From.Book, Chapter: p.From.Chapter, Superscription: true}
+	}
+	vmax, _ := p.To.Book.VersesInChapter(p.To.Chapter)
+	return NewBiblePassage(from, &BibleReference{Book: p.To.Book, Chapter: p.To.Chapter, Verse: vmax})
+}
+
+// Verses returns a reference for every verse the passage covers, in order. The
+// first and last verses keep the passage's fragments. A Psalm title sits before
+// verse 1 of its psalm, so it is included when the passage starts or ends with it
+// or runs into its psalm from the one before; "Psalm 51" alone leaves it out.
+// Passages crossing books step through the books of the parser that produced them.
+func (p *BiblePassage) Verses() []*BibleReference {
+	from, to := p.From, p.To
+	if from.Book == to.Book && from.Chapter == to.Chapter && from.Verse == to.Verse && !from.wholeChapter() && !to.wholeChapter() {
+		ref := *from
+		if from.Fragment != to.Fragment {
+			ref.Fragment = ""
+		}
+		return []*BibleReference{&ref}
+	}
+
+	refs := []*BibleReference{}
+	book, chapter, verse := from.Book, from.Chapter, from.firstVerse()
+	if from.Superscription {
+		ref := *from
+		refs = append(refs, &ref)
+		verse = 1
+	}
+	for book != nil {
+		if book == to.Book && chapter == to.Chapter && to.Superscription {
+			ref := *to
+			return append(refs, &ref)
+		}
+		ref := &BibleReference{Book: book, Chapter: chapter, Verse: verse}
+		if len(refs) == 0 {
+			ref.Fragment = from.Fragment
+		}
+		last := book == to.Book && chapter == to.Chapter && verse == to.lastVerse()
+		if last {
+			ref.Fragment = to.Fragment
+		}
+		refs = append(refs, ref)
+		if last {
+			break
+		}
+
+		verse++
+		if vmax, err := book.VersesInChapter(chapter); err != nil || verse > vmax {
+			chapter, verse = chapter+1, 1
+		}
+		if chapter > book.ChaptersInBook() {
+			book, chapter = book.next, 1
+		}
+		if verse == 1 && book != nil && book.HasSuperscription(chapter) && !(book == to.Book && chapter == to.Chapter && to.Superscription) {
+			refs = append(refs, &BibleReference{Book: book, Chapter: chapter, Superscription: true})
+		}
+	}
+	return refs
+}
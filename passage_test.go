@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -37,11 +38,12 @@ func TestPassage_Stringify(t *testing.T) {
 		{"readme example b", makeRef("1 John", 5, 19, ""), makeRef("1 John", 5, 21, ""), "1 John 5:19-21"},
 		{"readme example c", makeRef("Esther", 2, 1, ""), makeRef("Esther", 2, 23, ""), "Esther 2"},
 		{"fragment", makeRef("Philippians", 2, 14, ""), makeRef("Philippians", 2, 15, "a"), "Philippians 2:14-15a"},
+		{"cross-book same chapter", makeRef("Obadiah", 1, 21, ""), makeRef("Jonah", 1, 2, ""), "Obadiah 1:21 - Jonah 1:2"},
 		{"another fragment", makeRef("Mark", 1, 4, "b"), makeRef("Mark", 1, 15, ""), "Mark 1:4b-15"},
 		{"entire book", makeRef("John", 1, 1, ""), makeRef("John", 21, 25, ""), "John"},
 		{"whole chapter", makeRef("John", 3, 1, ""), makeRef("John", 3, 36, ""), "John 3"},
 		{"single verse", makeRef("John", 3, 16, ""), makeRef("John", 3, 16, ""), "John 3:16"},
-		{"multiple whole books", makeRef("Genesis", 1, 1, ""), makeRef("Exodus", 40, 38, ""), "Genesis 1:1 - Exodus 40:38"},
+		{"multiple whole books", makeRef("Genesis", 1, 1, ""), makeRef("Exodus", 40, 38, ""), "Genesis - Exodus"},
 		{"passage spanning different chapters", makeRef("Genesis", 1, 1, ""), makeRef("Genesis", 4, 26, ""), "Genesis 1-4"},
 		{"passage spanning different chapters with odd verses", makeRef("Genesis", 1, 5, ""), makeRef("Genesis", 4, 10, ""), "Genesis 1:5-4:10"},
 		{"passage spanning different book", makeRef("Genesis", 1, 1, ""), makeRef("Exodus", 5, 2, ""), "Genesis 1:1 - Exodus 5:2"},
@@ -62,3 +64,46 @@ func TestPassage_Stringify(t *testing.T) {
 		})
 	}
 }
+
+func TestPassage_Verses(t *testing.T) {
+	p := NewBiblePassageParser()
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"John 3:16", []string{"John 3:16"}},
+		{"John 3:16-18", []string{"John 3:16", "John 3:17", "John 3:18"}},
+		{"John 3:35-4:2", []string{"John 3:35", "John 3:36", "John 4:1", "John 4:2"}},
+		{"John 3:16b-17a", []string{"John 3:16b", "John 3:17a"}},
+		{"John 3:16a-16c", []string{"John 3:16"}},
+		{"Psalm 51:title-2", []string{"Psalms 51:title", "Psalms 51:1", "Psalms 51:2"}},
+		{"Psalm 50:23-51:1", []string{"Psalms 50:23", "Psalms 51:title", "Psalms 51:1"}},
+		{"Psalm 50:22-51:title", []string{"Psalms 50:22", "Psalms 50:23", "Psalms 51:title"}},
+		{"Mal 4:5 - Matt 1:2", []string{"Malachi 4:5", "Malachi 4:6", "Matthew 1:1", "Matthew 1:2"}},
+	}
+	for _, c := range cases {
+		passages, err := p.Parse(c.in)
+		if err != nil {
+			t.Fatalf("parse %q: %v", c.in, err)
+		}
+		got := []string{}
+		for _, ref := range passages[0].Verses() {
+			got = append(got, ref.String())
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%q: got %q, want %q", c.in, got, c.want)
+		}
+	}
+	if n := len(mustParse(t, p, "Genesis")[0].Verses()); n != 1533 {
+		t.Errorf("Genesis has %d verses", n)
+	}
+}
+
+func mustParse(t testing.TB, p *BiblePassageParser, s string) []*BiblePassage {
+	t.Helper()
+	passages, err := p.Parse(s)
+	if err != nil {
+		t.Fatalf("parse %q: %v", s, err)
+	}
+	return passages
+}
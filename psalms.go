@@ -0,0 +1,42 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/gotedo/bible-chapter-verse-parser/data"
+)
+
+// NewSuperscriptionReference returns the title of a Psalm ("Psalm 51 title") as a
+// reference of its own. It sorts before verse 1 of the psalm. Only psalms listed in
+// data.PsalmTitles have a superscription.
+func NewSuperscriptionReference(book *Book, chapter int) (*BibleReference, error) {
+	if !book.psalms {
+		return nil, fmt.Errorf("%s %d has no superscription", book.SingularName, chapter)
+	}
+	if _, ok := data.PsalmTitles[chapter]; !ok {
+		return nil, fmt.Errorf("%s %d has no superscription", book.SingularName, chapter)
+	}
+	return &BibleReference{Book: book, Chapter: chapter, Superscription: true}, nil
+}
+
+// HasSuperscription reports whether the chapter opens with a superscription.
+func (b *Book) HasSuperscription(chapter int) bool {
+	if !b.psalms {
+		return false
+	}
+	_, ok := data.PsalmTitles[chapter]
+	return ok
+}
+
+// MasoreticVerse returns the verse number in the Hebrew (Masoretic) numbering. It
+// differs from the English numbering only in Psalms whose title is numbered as one
+// or two verses of its own; a superscription is verse 1 in the Hebrew.
+func (r *BibleReference) MasoreticVerse() int {
+	if r.Superscription {
+		return 1
+	}
+	if !r.Book.psalms {
+		return r.Verse
+	}
+	return r.Verse + data.PsalmTitles[r.Chapter]
+}
@@ -0,0 +1,157 @@
+package parser
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/gotedo/bible-chapter-verse-parser/data"
+)
+
+func TestParse_Superscription(t *testing.T) {
+	p := NewBiblePassageParser()
+
+	cases := []struct {
+		name string
+		in   string
+		want [][]string
+		str  string
+	}{
+		{"verse zero", "Psalm 51:0", [][]string{{"Psalms 51:title", "Psalms 51:title"}}, "Psalm 51:title"},
+		{"title keyword", "Psalm 51 title", [][]string{{"Psalms 51:title", "Psalms 51:title"}}, "Psalm 51:title"},
+		{"superscription keyword", "Ps 3 superscription", [][]string{{"Psalms 3:title", "Psalms 3:title"}}, "Psalm 3:title"},
+		{"title after colon", "Psalm 51:title", [][]string{{"Psalms 51:title", "Psalms 51:title"}}, "Psalm 51:title"},
+		{"title to verse", "Psalm 51:0-4", [][]string{{"Psalms 51:title", "Psalms 51:4"}}, "Psalm 51:title-4"},
+		{"round trip", "Psalm 51:title-4", [][]string{{"Psalms 51:title", "Psalms 51:4"}}, "Psalm 51:title-4"},
+		{"chapter range to title", "Psalm 50-51:title", [][]string{{"Psalms 50:1", "Psalms 51:title"}}, "Psalm 50:1-51:title"},
+		{"whole psalm excludes the title", "Psalm 51", [][]string{{"Psalms 51:1", "Psalms 51:19"}}, "Psalm 51"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := p.Parse(c.in)
+			if err != nil {
+				t.Fatalf("parse error for %q: %v", c.in, err)
+			}
+			gotPairs := make([][]string, len(got))
+			for i, pass := range got {
+				gotPairs[i] = []string{pass.From.String(), pass.To.String()}
+			}
+			if !reflect.DeepEqual(gotPairs, c.want) {
+				t.Fatalf("mismatch for %q\n got: %#v\nwant: %#v", c.in, gotPairs, c.want)
+			}
+			if s := got[0].String(); s != c.str {
+				t.Fatalf("got %q want %q", s, c.str)
+			}
+		})
+	}
+}
+
+func TestParse_SuperscriptionInvalid(t *testing.T) {
+	p := NewBiblePassageParser()
+
+	cases := []string{"Psalm 1:0", "Psalm 119 title", "John 3:0", "Psalm title", "Psalm 51:4-title"}
+	for _, c := range cases {
+		t.Run(c, func(t *testing.T) {
+			_, err := p.Parse(c)
+			if err == nil {
+				t.Fatalf("expected error for invalid input %q, got nil", c)
+			}
+		})
+	}
+}
+
+func TestMasoreticVerse(t *testing.T) {
+	p := NewBiblePassageParser()
+	psalms, _ := p.Book("Psalms")
+	john, _ := p.Book("John")
+
+	title, err := NewSuperscriptionReference(psalms, 51)
+	if err != nil {
+		t.Fatalf("NewSuperscriptionReference error: %v", err)
+	}
+
+	cases := []struct {
+		name string
+		ref  *BibleReference
+		want int
+	}{
+		{"title", title, 1},
+		{"two verse title", &BibleReference{Book: psalms, Chapter: 51, Verse: 1}, 3},
+		{"one verse title", &BibleReference{Book: psalms, Chapter: 3, Verse: 8}, 9},
+		{"title within verse one", &BibleReference{Book: psalms, Chapter: 23, Verse: 1}, 1},
+		{"no title", &BibleReference{Book: psalms, Chapter: 1, Verse: 6}, 6},
+		{"other book", &BibleReference{Book: john, Chapter: 3, Verse: 16}, 16},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.ref.MasoreticVerse(); got != c.want {
+				t.Fatalf("got %d want %d", got, c.want)
+			}
+		})
+	}
+
+	if !psalms.HasSuperscription(51) || psalms.HasSuperscription(1) || john.HasSuperscription(1) {
+		t.Fatalf("unexpected HasSuperscription results")
+	}
+}
+
+func TestSuperscription_WithStructure(t *testing.T) {
+	// Psalms as book 1 and another book as number 19
+	structure := map[int]data.BookData{}
+	for num := 1; num <= 19; num++ {
+		structure[num] = data.BookData{Name: fmt.Sprintf("Book %c", 'a'+num), SingularName: fmt.Sprintf("Book %c", 'a'+num), Chapters: []int{10, 10, 10}}
+	}
+	structure[1] = data.BookData{Name: "Tehillim", SingularName: "Tehillim", Abbreviations: []string{"Psalms", "ps"}, Chapters: []int{6, 12, 8}}
+	p := NewBiblePassageParser(WithStructure(structure))
+
+	got, err := p.Normalise("Ps 3:title-2")
+	if err != nil || got != "Tehillim 3:title-2" {
+		t.Fatalf("Normalise = %q, %v", got, err)
+	}
+	psalms, _ := p.Book("Tehillim")
+	if v := (&BibleReference{Book: psalms, Chapter: 3, Verse: 2}).MasoreticVerse(); v != 3 {
+		t.Errorf("MasoreticVerse(Tehillim 3:2) = %d, want 3", v)
+	}
+	other, _ := p.Book("Book t")
+	if other.Number != 19 || other.HasSuperscription(3) {
+		t.Errorf("book 19 of the structure has Psalm titles")
+	}
+	if _, err := p.Parse("Book t 3:0"); err == nil {
+		t.Errorf("title accepted on book 19")
+	}
+}
+
+// TestSuperscription_InRanges checks that every API agrees a Psalm title sits
+// before verse 1 of its psalm: inside a range that runs into the psalm, outside a
+// range of the psalm's verses alone.
+func TestSuperscription_InRanges(t *testing.T) {
+	p := NewBiblePassageParser()
+	title := mustParse(t, p, "Psalm 51:title")[0]
+
+	across := mustParse(t, p, "Psalm 50:23-51:1")[0]
+	verses := []string{}
+	for _, ref := range across.Verses() {
+		verses = append(verses, ref.String())
+	}
+	if want := []string{"Psalms 50:23", "Psalms 51:title", "Psalms 51:1"}; !reflect.DeepEqual(verses, want) {
+		t.Errorf("Verses() = %q, want %q", verses, want)
+	}
+	if !across.Contains(title.From) || !across.Overlaps(title) {
+		t.Errorf("Psalm 50:23-51:1 does not contain the title of Psalm 51")
+	}
+
+	psalm := mustParse(t, p, "Psalm 51")[0]
+	if psalm.Contains(title.From) || psalm.Overlaps(title) {
+		t.Errorf("Psalm 51 contains its title")
+	}
+
+	set := NewPassageSet(mustParse(t, p, "Psalm 50")[0], psalm)
+	if set.Len() != 2 || set.Contains(title.From) {
+		t.Errorf("NewPassageSet(Psalm 50, Psalm 51) = %v, want the title left out", set.Passages())
+	}
+	set.Add(title)
+	if got := set.Passages(); len(got) != 1 || got[0].String() != "Psalms 50-51" {
+		t.Errorf("adding the title gave %v, want Psalms 50-51", got)
+	}
+}
@@ -1,25 +1,32 @@
 package parser
 
-import "fmt"
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
 
 type BibleReference struct {
 	Book     *Book
 	Chapter  int
 	Verse    int
 	Fragment string
+	// Superscription marks the title of a Psalm, addressed as verse 0 ("Psalm 51:0").
+	// Verse is 0 and Fragment is empty.
+	Superscription bool
 }
 
 func NewBibleReference(book *Book, chapter, verse int, fragment string) (*BibleReference, error) {
 	if verse > 0 {
-		vmax, ok := book.ChapterStructure[chapter]
-		if !ok {
-			return nil, fmt.Errorf("chapter %d does not exist in %s", chapter, book.Name)
+		vmax, err := book.VersesInChapter(chapter)
+		if err != nil {
+			return nil, err
 		}
 		if verse > vmax {
 			return nil, fmt.Errorf("verse %d does not exist in chapter %d of book %s", verse, chapter, book.Name)
 		}
 	}
-	if fragment != "" && fragment != "a" && fragment != "b" && fragment != "c" {
+	if !validFragment(book, fragment) {
 		return nil, fmt.Errorf("invalid fragment")
 	}
 	return &BibleReference{Book: book, Chapter: chapter, Verse: verse, Fragment: fragment}, nil
@@ -29,9 +36,104 @@ func (r *BibleReference) IntegerNotation() int {
 	return (1000000 * r.Book.Number) + (1000 * r.Chapter) + r.Verse
 }
 
+// Compare orders references by book, chapter, verse and then fragment, returning
+// -1, 0 or +1. A verse without a fragment starts the verse, so it sorts before its
+// fragments: "Mark 1:4" < "Mark 1:4a" < "Mark 1:4b" < "Mark 1:5". A Psalm title
+// sorts before verse 1.
+func (r *BibleReference) Compare(o *BibleReference) int {
+	return r.startPosition().compare(o.startPosition())
+}
+
+// Less reports whether r sorts before o.
+func (r *BibleReference) Less(o *BibleReference) bool {
+	return r.Compare(o) < 0
+}
+
+// Equal reports whether r and o address the same verse or fragment.
+func (r *BibleReference) Equal(o *BibleReference) bool {
+	return r.Compare(o) == 0
+}
+
 func (r *BibleReference) String() string {
+	if r.Superscription {
+		return fmt.Sprintf("%s %d:title", r.Book.Name, r.Chapter)
+	}
 	if r.Verse == 0 {
 		return fmt.Sprintf("%s %d", r.Book.Name, r.Chapter)
 	}
 	return fmt.Sprintf("%s %d:%d%s", r.Book.Name, r.Chapter, r.Verse, r.Fragment)
 }
+
+// wholeChapter reports whether r is a chapter without a verse, as made by
+// NewBibleReference with verse 0. It covers the chapter from verse 1 to its last
+// verse.
+func (r *BibleReference) wholeChapter() bool {
+	return r.Verse == 0 && !r.Superscription
+}
+
+// firstVerse is the verse r starts with: 1 for a whole chapter, else its verse.
+func (r *BibleReference) firstVerse() int {
+	if r.wholeChapter() {
+		return 1
+	}
+	return r.Verse
+}
+
+// lastVerse is the verse r ends with: the last verse of a whole chapter, else its
+// verse.
+func (r *BibleReference) lastVerse() int {
+	if r.wholeChapter() {
+		vmax, _ := r.Book.VersesInChapter(r.Chapter)
+		return vmax
+	}
+	return r.Verse
+}
+
+// verseLabel is the verse part of the shorthand: "16b", or "title" for a superscription.
+func (r *BibleReference) verseLabel() string {
+	if r.Superscription {
+		return "title"
+	}
+	return fmt.Sprintf("%d%s", r.Verse, r.Fragment)
+}
+
+// validFragment reports whether fragment is empty or a single letter of the
+// alphabet of the book's parser, set with WithFragments. A book made without a
+// parser accepts any lower-case letter.
+func validFragment(book *Book, fragment string) bool {
+	if fragment == "" {
+		return true
+	}
+	r, size := utf8.DecodeRuneInString(fragment)
+	if size != len(fragment) {
+		return false
+	}
+	if book.fragments == nil {
+		return unicode.IsLetter(r) && unicode.IsLower(r)
+	}
+	for _, f := range book.fragments {
+		if r == f {
+			return true
+		}
+	}
+	return false
+}
+
+// checkReference reports whether ref exists in its book: the chapter, and the verse
+// or Psalm title. NewBibleReference does not check verse 0.
+func checkReference(ref *BibleReference) error {
+	vmax, err := ref.Book.VersesInChapter(ref.Chapter)
+	if err != nil {
+		return err
+	}
+	if ref.Superscription {
+		if !ref.Book.HasSuperscription(ref.Chapter) {
+			return fmt.Errorf("%s %d has no superscription", ref.Book.SingularName, ref.Chapter)
+		}
+		return nil
+	}
+	if ref.Verse < 1 || ref.Verse > vmax {
+		return fmt.Errorf("verse %d does not exist in chapter %d of book %s", ref.Verse, ref.Chapter, ref.Book.Name)
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+// Package server exposes a BiblePassageParser over HTTP as a JSON API.
+//
+// The handler keeps no per-request state, so any number of instances can run
+// behind a load balancer. All requests share the single parser passed to NewHandler.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	parser "github.com/gotedo/bible-chapter-verse-parser"
+)
+
+const maxBodyBytes = 1 << 20
+
+type Reference struct {
+	Book       string `json:"book"`
+	BookNumber int    `json:"book_number"`
+	Chapter    int    `json:"chapter"`
+	Verse      int    `json:"verse"`
+	Fragment   string `json:"fragment,omitempty"`
+	// Superscription marks the title of a Psalm; Verse is then 0.
+	Superscription bool `json:"superscription,omitempty"`
+}
+
+type Passage struct {
+	From      Reference `json:"from"`
+	To        Reference `json:"to"`
+	Formatted string    `json:"formatted"`
+}
+
+type Match struct {
+	Text     string    `json:"text"`
+	Start    int       `json:"start"`
+	End      int       `json:"end"`
+	Passages []Passage `json:"passages"`
+}
+
+type Book struct {
+	Number        int      `json:"number"`
+	Name          string   `json:"name"`
+	SingularName  string   `json:"singular_name"`
+	Abbreviations []string `json:"abbreviations"`
+	Chapters      int      `json:"chapters"`
+	Verses        []int    `json:"verses"`
+}
+
+type Error struct {
+	Error string `json:"error"`
+	Input string `json:"input,omitempty"`
+	// Start and End locate the offending part of Input when the parser reports it.
+	Start *int `json:"start,omitempty"`
+	End   *int `json:"end,omitempty"`
+}
+
+type inputRequest struct {
+	Input string `json:"input"`
+}
+
+type formatRequest struct {
+	Passages []struct {
+		From Reference `json:"from"`
+		To   Reference `json:"to"`
+	} `json:"passages"`
+}
+
+type handler struct {
+	parser *parser.BiblePassageParser
+	mux    *http.ServeMux
+}
+
+// NewHandler returns an http.Handler serving:
+//
+//	GET|POST /parse       passages for "q" (or {"input": ...})
+//	GET|POST /normalise   the shorthand form of "q"
+//	GET|POST /extract     references found in free text
+//	POST     /format      shorthand strings for structured passages
+//	GET      /books       every book
+//	GET      /books/{name} a single book by name or abbreviation
+//	GET      /healthz     liveness probe
+//
+// Unparseable input is answered with 400 and an Error body.
+func NewHandler(p *parser.BiblePassageParser) http.Handler {
+	h := &handler{parser: p, mux: http.NewServeMux()}
+	h.mux.HandleFunc("/parse", h.parse)
+	h.mux.HandleFunc("/normalise", h.normalise)
+	h.mux.HandleFunc("/normalize", h.normalise)
+	h.mux.HandleFunc("/extract", h.extract)
+	h.mux.HandleFunc("/format", h.format)
+	h.mux.HandleFunc("/books", h.books)
+	h.mux.HandleFunc("/books/", h.book)
+	h.mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	return h
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *handler) parse(w http.ResponseWriter, r *http.Request) {
+	input, ok := readInput(w, r)
+	if !ok {
+		return
+	}
+	passages, err := h.parser.Parse(input)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, parseError(err, input))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string][]Passage{"passages": toPassages(passages)})
+}
+
+func (h *handler) normalise(w http.ResponseWriter, r *http.Request) {
+	input, ok := readInput(w, r)
+	if !ok {
+		return
+	}
+	normalised, err := h.parser.Normalise(input)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, parseError(err, input))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"normalised": normalised})
+}
+
+func (h *handler) extract(w http.ResponseWriter, r *http.Request) {
+	input, ok := readInput(w, r)
+	if !ok {
+		return
+	}
+	found := h.parser.Extract(input)
+	matches := make([]Match, len(found))
+	for i, m := range found {
+		matches[i] = Match{Text: m.Text, Start: m.Start, End: m.End, Passages: toPassages(m.Passages)}
+	}
+	writeJSON(w, http.StatusOK, map[string][]Match{"matches": matches})
+}
+
+func (h *handler) format(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		methodNotAllowed(w, http.MethodPost)
+		return
+	}
+	var req formatRequest
+	if err := decodeBody(w, r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, Error{Error: err.Error()})
+		return
+	}
+	formatted := make([]string, len(req.Passages))
+	for i, pass := range req.Passages {
+		from, err := h.reference(pass.From)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, Error{Error: err.Error()})
+			return
+		}
+		to, err := h.reference(pass.To)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, Error{Error: err.Error()})
+			return
+		}
+		passage := parser.NewBiblePassage(from, to)
+		if err := passage.Validate(); err != nil {
+			writeJSON(w, http.StatusBadRequest, Error{Error: err.Error()})
+			return
+		}
+		formatted[i] = passage.String()
+	}
+	writeJSON(w, http.StatusOK, map[string][]string{"formatted": formatted})
+}
+
+func (h *handler) books(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w, http.MethodGet)
+		return
+	}
+	all := h.parser.Books()
+	books := make([]Book, len(all))
+	for i, b := range all {
+		books[i] = toBook(b)
+	}
+	writeJSON(w, http.StatusOK, map[string][]Book{"books": books})
+}
+
+func (h *handler) book(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w, http.MethodGet)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, "/books/")
+	b, err := h.parser.Book(name)
+	if err != nil {
+		writeJSON(w, http.StatusNotFound, Error{Error: err.Error(), Input: name})
+		return
+	}
+	writeJSON(w, http.StatusOK, toBook(b))
+}
+
+func (h *handler) reference(ref Reference) (*parser.BibleReference, error) {
+	name := ref.Book
+	if name == "" && ref.BookNumber != 0 {
+		for _, b := range h.parser.Books() {
+			if b.Number == ref.BookNumber {
+				name = b.Name
+			}
+		}
+	}
+	b, err := h.parser.Book(name)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Superscription {
+		return parser.NewSuperscriptionReference(b, ref.Chapter)
+	}
+	return parser.NewBibleReference(b, ref.Chapter, ref.Verse, ref.Fragment)
+}
+
+// readInput accepts the input either as the "q" query parameter of a GET request
+// or as a JSON body ({"input": "..."}) of a POST request.
+func readInput(w http.ResponseWriter, r *http.Request) (string, bool) {
+	switch r.Method {
+	case http.MethodGet:
+		return r.URL.Query().Get("q"), true
+	case http.MethodPost:
+		var req inputRequest
+		if err := decodeBody(w, r, &req); err != nil {
+			writeJSON(w, http.StatusBadRequest, Error{Error: err.Error()})
+			return "", false
+		}
+		return req.Input, true
+	}
+	methodNotAllowed(w, http.MethodGet, http.MethodPost)
+	return "", false
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return errors.New("invalid request body: " + err.Error())
+	}
+	return nil
+}
+
+func parseError(err error, input string) Error {
+	e := Error{Error: err.Error(), Input: input}
+	var pe *parser.ParseError
+	if errors.As(err, &pe) {
+		e.Start, e.End = &pe.Start, &pe.End
+	}
+	return e
+}
+
+func methodNotAllowed(w http.ResponseWriter, allowed ...string) {
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	writeJSON(w, http.StatusMethodNotAllowed, Error{Error: "method not allowed"})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func toReference(r *parser.BibleReference) Reference {
+	return Reference{Book: r.Book.Name, BookNumber: r.Book.Number, Chapter: r.Chapter, Verse: r.Verse, Fragment: r.Fragment, Superscription: r.Superscription}
+}
+
+func toPassages(passages []*parser.BiblePassage) []Passage {
+	out := make([]Passage, len(passages))
+	for i, pass := range passages {
+		out[i] = Passage{From: toReference(pass.From), To: toReference(pass.To), Formatted: pass.String()}
+	}
+	return out
+}
+
+func toBook(b *parser.Book) Book {
+	verses := make([]int, b.ChaptersInBook())
+	for ch := range verses {
+		verses[ch], _ = b.VersesInChapter(ch + 1)
+	}
+	return Book{Number: b.Number, Name: b.Name, SingularName: b.SingularName, Abbreviations: b.Abbreviations, Chapters: b.ChaptersInBook(), Verses: verses}
+}
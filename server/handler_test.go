@@ -0,0 +1,74 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	parser "github.com/gotedo/bible-chapter-verse-parser"
+)
+
+func TestHandler(t *testing.T) {
+	h := NewHandler(parser.NewBiblePassageParser())
+
+	cases := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		status int
+		want   string
+	}{
+		{"parse", "GET", "/parse?q=John+3:16-18", "", 200, `"formatted":"John 3:16-18"`},
+		{"parse post", "POST", "/parse", `{"input":"Psalm 23"}`, 200, `"book":"Psalms","book_number":19,"chapter":23,"verse":1`},
+		{"parse superscription", "GET", "/parse?q=Psalm+51:title-4", "", 200, `"from":{"book":"Psalms","book_number":19,"chapter":51,"verse":0,"superscription":true}`},
+		{"parse error", "GET", "/parse?q=Bob", "", 400, `"error":"invalid book name \"bob\"","input":"Bob","start":0,"end":3`},
+		{"parse error position", "GET", "/parse?q=John+3:16+%26+Bob+4", "", 400, `"input":"John 3:16 \u0026 Bob 4","start":12,"end":15`},
+		{"parse empty", "GET", "/parse", "", 400, `"error":"unable to parse reference"`},
+		{"normalise", "GET", "/normalise?q=jn+3v16+%26+ps+23", "", 200, `{"normalised":"John 3:16; Psalm 23"}`},
+		{"extract", "POST", "/extract", `{"input":"Read John 3:16 today"}`, 200, `"text":"John 3:16","start":5,"end":14`},
+		{"format", "POST", "/format", `{"passages":[{"from":{"book":"Gen","chapter":1,"verse":1},"to":{"book":"Genesis","chapter":4,"verse":26}}]}`, 200, `{"formatted":["Genesis 1-4"]}`},
+		{"format reversed", "POST", "/format", `{"passages":[{"from":{"book":"John","chapter":3,"verse":17},"to":{"book":"John","chapter":3,"verse":16}}]}`, 400, `"error":"references end is before beginning"`},
+		{"format reversed fragments", "POST", "/format", `{"passages":[{"from":{"book":"John","chapter":3,"verse":16,"fragment":"b"},"to":{"book":"John","chapter":3,"verse":16,"fragment":"a"}}]}`, 400, `"error":"references end is before beginning"`},
+		{"format bad verse", "POST", "/format", `{"passages":[{"from":{"book":"John","chapter":3,"verse":99},"to":{"book":"John","chapter":3,"verse":99}}]}`, 400, `"error":"verse 99 does not exist`},
+		{"format method", "GET", "/format", "", 405, `"error":"method not allowed"`},
+		{"bad body", "POST", "/parse", `{"nope":1}`, 400, `"error":"invalid request body`},
+		{"book", "GET", "/books/1%20cor", "", 200, `"name":"1 Corinthians","singular_name":"1 Corinthians"`},
+		{"unknown book", "GET", "/books/bob", "", 404, `"error":"invalid book name \"bob\""`},
+		{"healthz", "GET", "/healthz", "", 200, `{"status":"ok"}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := httptest.NewRequest(c.method, c.target, strings.NewReader(c.body))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != c.status {
+				t.Fatalf("status %d want %d (body %s)", rec.Code, c.status, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), c.want) {
+				t.Fatalf("body %s does not contain %s", rec.Body.String(), c.want)
+			}
+		})
+	}
+}
+
+func TestHandler_Books(t *testing.T) {
+	h := NewHandler(parser.NewBiblePassageParser())
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/books", nil))
+
+	var resp struct {
+		Books []Book `json:"books"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(resp.Books) != 66 || resp.Books[0].Name != "Genesis" || resp.Books[65].Name != "Revelation" {
+		t.Fatalf("unexpected books: %d", len(resp.Books))
+	}
+	if resp.Books[18].Chapters != 150 || resp.Books[18].Verses[118] != 176 {
+		t.Fatalf("unexpected Psalms data: %+v", resp.Books[18].Chapters)
+	}
+}
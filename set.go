@@ -0,0 +1,183 @@
+package parser
+
+import (
+	"math"
+	"sort"
+	"unicode/utf8"
+)
+
+// position is a point on the line of verse parts. verse is the IntegerNotation of
+// the verse and part orders the fragments within it: 0 is the start of the verse,
+// endOfVerse its end and each fragment sits at its code point in between. A
+// passage covers every position from the start of From to the end of To.
+type position struct {
+	verse int
+	part  int
+}
+
+const endOfVerse = math.MaxInt32
+
+func (a position) compare(b position) int {
+	switch {
+	case a.verse < b.verse:
+		return -1
+	case a.verse > b.verse:
+		return 1
+	case a.part < b.part:
+		return -1
+	case a.part > b.part:
+		return 1
+	}
+	return 0
+}
+
+func fragmentRank(fragment string) int {
+	r, _ := utf8.DecodeRuneInString(fragment)
+	return int(r)
+}
+
+// startPosition is where r begins: "16" starts with the verse, "16b" at fragment b
+// and a whole chapter with its verse 1.
+func (r *BibleReference) startPosition() position {
+	verse := r.IntegerNotation() - r.Verse + r.firstVerse()
+	if r.Fragment == "" {
+		return position{verse, 0}
+	}
+	return position{verse, fragmentRank(r.Fragment)}
+}
+
+// endPosition is where r ends: "16" ends with the verse, "16b" at fragment b and a
+// whole chapter with its last verse.
+func (r *BibleReference) endPosition() position {
+	verse := r.IntegerNotation() - r.Verse + r.lastVerse()
+	if r.Fragment == "" {
+		return position{verse, endOfVerse}
+	}
+	return position{verse, fragmentRank(r.Fragment)}
+}
+
+// Contains reports whether the passage covers all of ref. A reference without a
+// fragment is the whole verse, so "John 3:16a" does not contain "John 3:16".
+func (p *BiblePassage) Contains(ref *BibleReference) bool {
+	return p.From.startPosition().compare(ref.startPosition()) <= 0 && ref.endPosition().compare(p.To.endPosition()) <= 0
+}
+
+// Overlaps reports whether the passages share any verse or fragment. "John 3:16a"
+// and "John 3:16b" are disjoint; both overlap "John 3:16".
+func (p *BiblePassage) Overlaps(q *BiblePassage) bool {
+	return p.From.startPosition().compare(q.To.endPosition()) <= 0 && q.From.startPosition().compare(p.To.endPosition()) <= 0
+}
+
+// Intersect returns the part the passages share, or nil when they are disjoint.
+func (p *BiblePassage) Intersect(q *BiblePassage) *BiblePassage {
+	if !p.Overlaps(q) {
+		return nil
+	}
+	from, to := p.From, p.To
+	if q.From.startPosition().compare(from.startPosition()) > 0 {
+		from = q.From
+	}
+	if q.To.endPosition().compare(to.endPosition()) < 0 {
+		to = q.To
+	}
+	f, t := *from, *to
+	return NewBiblePassage(&f, &t)
+}
+
+// PassageSet is a set of verses and verse fragments, kept as sorted, disjoint
+// passages. Overlapping passages and passages that continue each other within a
+// book ("John 3:1-16" and "John 3:17-4:2") are merged. The zero value is an empty set.
+type PassageSet struct {
+	passages []*BiblePassage
+}
+
+func NewPassageSet(passages ...*BiblePassage) *PassageSet {
+	s := &PassageSet{}
+	s.Add(passages...)
+	return s
+}
+
+// Add puts the passages into the set.
+func (s *PassageSet) Add(passages ...*BiblePassage) {
+	all := append(append([]*BiblePassage{}, s.passages...), copyPassages(passages)...)
+	sort.Sort(Passages(all))
+	merged := []*BiblePassage{}
+	for _, pass := range all {
+		if n := len(merged); n > 0 && (merged[n-1].Overlaps(pass) || continues(merged[n-1].To, pass.From)) {
+			if pass.To.endPosition().compare(merged[n-1].To.endPosition()) > 0 {
+				merged[n-1] = NewBiblePassage(merged[n-1].From, pass.To)
+			}
+			continue
+		}
+		merged = append(merged, pass)
+	}
+	s.passages = merged
+}
+
+// Passages returns copies of the passages in the set, in canonical order.
+func (s *PassageSet) Passages() []*BiblePassage {
+	return copyPassages(s.passages)
+}
+
+// Len is the number of disjoint passages in the set.
+func (s *PassageSet) Len() int {
+	return len(s.passages)
+}
+
+// Contains reports whether the set covers all of ref.
+func (s *PassageSet) Contains(ref *BibleReference) bool {
+	i := sort.Search(len(s.passages), func(i int) bool {
+		return s.passages[i].To.endPosition().compare(ref.endPosition()) >= 0
+	})
+	return i < len(s.passages) && s.passages[i].Contains(ref)
+}
+
+// Overlaps reports whether any passage of the set overlaps p.
+func (s *PassageSet) Overlaps(p *BiblePassage) bool {
+	i := sort.Search(len(s.passages), func(i int) bool {
+		return s.passages[i].To.endPosition().compare(p.From.startPosition()) >= 0
+	})
+	return i < len(s.passages) && s.passages[i].Overlaps(p)
+}
+
+// Union returns a new set with the passages of both sets.
+func (s *PassageSet) Union(o *PassageSet) *PassageSet {
+	return NewPassageSet(append(append([]*BiblePassage{}, s.passages...), o.passages...)...)
+}
+
+// Intersect returns a new set with only the verses and fragments in both sets.
+func (s *PassageSet) Intersect(o *PassageSet) *PassageSet {
+	out := []*BiblePassage{}
+	for i, j := 0, 0; i < len(s.passages) && j < len(o.passages); {
+		a, b := s.passages[i], o.passages[j]
+		if common := a.Intersect(b); common != nil {
+			out = append(out, common)
+		}
+		if a.To.endPosition().compare(b.To.endPosition()) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return NewPassageSet(out...)
+}
+
+// continues reports whether next starts right after end, i.e. end is a whole verse
+// and next starts at the beginning of what follows it in the same book. A Psalm
+// title sits before verse 1 of its psalm, so the last verse of Psalm 50 is
+// followed by the title of Psalm 51 and "Psalm 50" and "Psalm 51" do not merge.
+func continues(end, next *BibleReference) bool {
+	if end.Book != next.Book || end.Fragment != "" || next.Fragment != "" {
+		return false
+	}
+	if end.Superscription {
+		return next.Chapter == end.Chapter && !next.Superscription && next.firstVerse() == 1
+	}
+	if last, err := end.Book.VersesInChapter(end.Chapter); err == nil && end.lastVerse() == last {
+		if end.Book.HasSuperscription(end.Chapter + 1) {
+			return next.Chapter == end.Chapter+1 && next.Superscription
+		}
+		return next.Chapter == end.Chapter+1 && !next.Superscription && next.firstVerse() == 1
+	}
+	return next.Chapter == end.Chapter && !next.Superscription && next.firstVerse() == end.lastVerse()+1
+}
@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse_Strict(t *testing.T) {
+	lenient := NewBiblePassageParser()
+	strict := NewBiblePassageParser(WithStrict())
+
+	// accepted by both parsers with the same result
+	for _, in := range []string{
+		"John 3:16", "John 3:16-18, 4:1", "John 3.16", "John 3 v 16", "John 3:16, 18", "John 3, 4",
+		"Jude", "Jude 1:5", "Jude v5", "Jude 1:3-5", "Gen 1:1 to end", "John 3-end", "John 3:16b-17a",
+		"Rom 8:28f", "John 3:16ff", "Gen 12f", "Psalm 51 title", "Psalm 51:title-2",
+		"Gen 1:1; 2:3", "Gen 1; 2", "Gen 1:1; Exod 2",
+	} {
+		want, err := lenient.Normalise(in)
+		if err != nil {
+			t.Fatalf("lenient parse %q: %v", in, err)
+		}
+		got, err := strict.Normalise(in)
+		if err != nil {
+			t.Errorf("strict parse %q: %v", in, err)
+		} else if got != want {
+			t.Errorf("strict parse %q = %q, want %q", in, got, want)
+		}
+	}
+
+	// accepted only by the lenient parser
+	cases := []struct {
+		in, err string
+	}{
+		{"Jude 1", "Jude 1 is ambiguous: write Jude 1:1 for a verse or Jude for the book"},
+		{"Jude 1-7", "Jude 1 is ambiguous: write Jude 1:1 for a verse or Jude for the book"},
+		{"john 3 16", `verse "16" must be separated from its chapter by ':' or 'v'`},
+		{"John 3 16-18", `verse "16" must be separated from its chapter by ':' or 'v'`},
+		{"John 3a", `fragment "a" on chapter 3`},
+		{"John 3-4a", `fragment "a" on chapter 4`},
+		{"John 3:36f", `"f" runs past the end of John 3`},
+		{"Rev 22ff", `"ff" runs past the end of Revelation`},
+		{"John 3:16 -", "range has no end"},
+		{"John 3:16 to", "range has no end"},
+		{"Gen 1:1; 2", `2 after ";" is ambiguous: write 1:2 for a verse or Genesis 2 for the chapter`},
+	}
+	for _, c := range cases {
+		if _, err := lenient.Parse(c.in); err != nil {
+			t.Errorf("lenient parse %q: %v", c.in, err)
+		}
+		_, err := strict.Parse(c.in)
+		var pe *ParseError
+		if err == nil || err.Error() != c.err || !errors.As(err, &pe) {
+			t.Errorf("strict parse %q: got %v, want %q", c.in, err, c.err)
+		}
+	}
+
+	// rejected by both, but strict mode says why
+	if _, err := strict.Parse("Jude 5"); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("Jude 5: got %v", err)
+	}
+	if _, err := NewBiblePassageParser(WithStrict(), WithFollowing(3)).Parse("John 3:35ff"); err == nil {
+		t.Error("John 3:35ff with WithFollowing(3) must fail in strict mode")
+	}
+}
@@ -1,15 +1,27 @@
 package parser
 
 import (
-	"regexp"
 	"strings"
+	"unicode"
 )
 
+// StandardiseString lower-cases s, trims surrounding whitespace and drops every
+// character other than a letter, 0-9 and space. Letters outside ASCII are kept, so
+// "Génesis" is "génesis"; s is not Unicode-normalised, so a decomposed "é" (e and a
+// combining accent) loses its accent.
 func StandardiseString(s string) string {
 	s = strings.TrimSpace(s)
-	s = strings.ToLower(s)
-	s = regexp.MustCompile("[^a-z0-9 ]").ReplaceAllString(s, "")
-	return s
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case ('0' <= r && r <= '9') || r == ' ':
+			b.WriteRune(r)
+		case unicode.IsLetter(r):
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
 }
 
 func SplitOnSeparators(separators []string, text string) []string {
@@ -0,0 +1,294 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gotedo/bible-chapter-verse-parser/data"
+)
+
+// WithStructure builds the parser's books from structure instead of the compiled-in
+// data.BibleStructure, e.g. one read with LoadStructure. Books may give their
+// chapters as ChapterStructure or Chapters. Book numbers must run from 1 without
+// gaps, as passages cross from one book to the next by number. NewBiblePassageParser
+// does not check the table: a chapter after a gap is dropped and a shared
+// abbreviation names the last book given it. Build the parser with
+// NewCheckedBiblePassageParser, or read the table with LoadStructure, to have it
+// checked. Psalm titles belong
+// to the book that "Psalms" names, whatever its number.
+func WithStructure(structure map[int]data.BookData) Option {
+	return func(p *BiblePassageParser) {
+		if len(structure) == 0 {
+			return
+		}
+		p.structure = make(map[int]data.BookData, len(structure))
+		for num, bd := range structure {
+			if bd.ChapterStructure == nil {
+				// books may give their chapters as an ordered slice only
+				bd.ChapterStructure = bd.ChapterMap()
+			}
+			p.structure[num] = bd
+		}
+	}
+}
+
+// structureBook is a book as written in a structure file. Chapters lists the
+// number of verses of each chapter, starting with chapter 1.
+type structureBook struct {
+	Number        int      `json:"number"`
+	Name          string   `json:"name"`
+	SingularName  string   `json:"singular_name"`
+	Abbreviations []string `json:"abbreviations"`
+	Chapters      []int    `json:"chapters"`
+}
+
+// LoadStructure reads a table of books and checks it: book numbers run from 1
+// without gaps, every book has a name and at least one chapter, every chapter at
+// least one verse, and no name or abbreviation refers to two books. A missing
+// singular_name is the name. The format is recognised from the content:
+//
+//   - JSON, an array of books:
+//     [{"number": 1, "name": "Genesis", "singular_name": "Genesis", "abbreviations": ["gen", "gn"], "chapters": [31, 25, ...]}, ...]
+//   - CSV with a header row naming the columns number, name, singular_name,
+//     abbreviations and chapters, in any order. Abbreviations and verse counts are
+//     separated by ";".
+//   - YAML, a sequence of books with the same keys as the JSON. Only this block
+//     style is understood, with flow ("[a, b]") or block ("- a") lists; anchors,
+//     multi-line strings and nested mappings are not.
+//
+// For example:
+//
+//	# books.yaml
+//	- number: 1
+//	  name: Genesis
+//	  abbreviations: [gen, gn]
+//	  chapters: [31, 25, 24]
+func LoadStructure(r io.Reader) (map[int]data.BookData, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var books []structureBook
+	switch trimmed := bytes.TrimSpace(b); {
+	case len(trimmed) == 0:
+		return nil, errors.New("structure: no books")
+	case trimmed[0] == '[':
+		err = json.Unmarshal(trimmed, &books)
+	case trimmed[0] == '-' || trimmed[0] == '#':
+		books, err = parseStructureYAML(trimmed)
+	default:
+		books, err = parseStructureCSV(trimmed)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("structure: %w", err)
+	}
+
+	structure := map[int]data.BookData{}
+	for _, sb := range books {
+		if _, ok := structure[sb.Number]; ok {
+			return nil, fmt.Errorf("structure: book %d appears twice", sb.Number)
+		}
+		bd := data.BookData{Name: sb.Name, SingularName: sb.SingularName, Abbreviations: sb.Abbreviations, Chapters: sb.Chapters}
+		if bd.SingularName == "" {
+			bd.SingularName = bd.Name
+		}
+		bd.ChapterStructure = bd.ChapterMap()
+		structure[sb.Number] = bd
+	}
+	if len(structure) == 0 {
+		return nil, errors.New("structure: no books")
+	}
+	if _, err := NewCheckedBiblePassageParser(WithStructure(structure)); err != nil {
+		return nil, fmt.Errorf("structure: %w", err)
+	}
+	return structure, nil
+}
+
+func parseStructureCSV(b []byte) ([]structureBook, error) {
+	r := csv.NewReader(bytes.NewReader(b))
+	r.TrimLeadingSpace = true
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, name := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"number", "name", "chapters"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("csv: no %q column", name)
+		}
+	}
+	field := func(row []string, name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	books := []structureBook{}
+	for i, row := range rows[1:] {
+		var sb structureBook
+		var err error
+		if sb.Number, err = strconv.Atoi(field(row, "number")); err != nil {
+			return nil, fmt.Errorf("csv: row %d: invalid book number %q", i+2, field(row, "number"))
+		}
+		sb.Name, sb.SingularName = field(row, "name"), field(row, "singular_name")
+		sb.Abbreviations = splitList(field(row, "abbreviations"))
+		if sb.Chapters, err = atoiList(splitList(field(row, "chapters"))); err != nil {
+			return nil, fmt.Errorf("csv: row %d: %w", i+2, err)
+		}
+		books = append(books, sb)
+	}
+	return books, nil
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func atoiList(items []string) ([]int, error) {
+	ns := make([]int, len(items))
+	for i, item := range items {
+		n, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid verse count %q", item)
+		}
+		ns[i] = n
+	}
+	return ns, nil
+}
+
+// parseStructureYAML reads the subset of YAML described at LoadStructure.
+func parseStructureYAML(b []byte) ([]structureBook, error) {
+	books := []structureBook{}
+	var book *structureBook
+	var list *[]string // the block list being read, if any
+	var lists map[string]*[]string
+	finish := func() error {
+		if book == nil {
+			return nil
+		}
+		var err error
+		book.Abbreviations = *lists["abbreviations"]
+		if book.Chapters, err = atoiList(*lists["chapters"]); err != nil {
+			return fmt.Errorf("%s: %w", book.Name, err)
+		}
+		books = append(books, *book)
+		return nil
+	}
+
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimRight(stripYAMLComment(s.Text()), " \t")
+		trimmed := strings.TrimLeft(text, " ")
+		if trimmed == "" || trimmed == "---" {
+			continue
+		}
+		indent := len(text) - len(trimmed)
+		if indent == 0 {
+			if !strings.HasPrefix(trimmed, "- ") {
+				return nil, fmt.Errorf("yaml: line %d: expected \"- \" to start a book", line)
+			}
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			book, list = &structureBook{}, nil
+			lists = map[string]*[]string{"abbreviations": {}, "chapters": {}}
+			trimmed = strings.TrimSpace(trimmed[2:])
+		} else if list != nil && strings.HasPrefix(trimmed, "- ") {
+			*list = append(*list, yamlScalar(trimmed[2:]))
+			continue
+		}
+
+		if book == nil {
+			return nil, fmt.Errorf("yaml: line %d: key outside a book", line)
+		}
+		k, v, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			return nil, fmt.Errorf("yaml: line %d: expected \"key: value\"", line)
+		}
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		list = nil
+		switch k {
+		case "number":
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("yaml: line %d: invalid book number %q", line, v)
+			}
+			book.Number = n
+		case "name":
+			book.Name = yamlScalar(v)
+		case "singular_name":
+			book.SingularName = yamlScalar(v)
+		case "abbreviations", "chapters":
+			list = lists[k]
+			if v != "" {
+				if !strings.HasPrefix(v, "[") || !strings.HasSuffix(v, "]") {
+					return nil, fmt.Errorf("yaml: line %d: %s must be a list", line, k)
+				}
+				for _, item := range strings.Split(v[1:len(v)-1], ",") {
+					if item = yamlScalar(item); item != "" {
+						*list = append(*list, item)
+					}
+				}
+				list = nil
+			}
+		default:
+			return nil, fmt.Errorf("yaml: line %d: unknown key %q", line, k)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if err := finish(); err != nil {
+		return nil, fmt.Errorf("yaml: %w", err)
+	}
+	return books, nil
+}
+
+// yamlScalar unquotes a plain or quoted scalar.
+func yamlScalar(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		if s[0] == '"' {
+			if u, err := strconv.Unquote(s); err == nil {
+				return u
+			}
+		}
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+	}
+	return s
+}
+
+// stripYAMLComment removes a "#" comment that is not inside quotes.
+func stripYAMLComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return s[:i]
+		}
+	}
+	return s
+}
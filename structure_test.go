@@ -0,0 +1,143 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gotedo/bible-chapter-verse-parser/data"
+)
+
+const structureJSON = `[
+	{"number": 1, "name": "Genesis", "abbreviations": ["gen", "gn"], "chapters": [31, 25, 24]},
+	{"number": 2, "name": "Psalms", "singular_name": "Psalm", "abbreviations": ["ps", "psalm"], "chapters": [6, 12]},
+	{"number": 3, "name": "Song of Songs", "abbreviations": ["song", "canticles"], "chapters": [17]}
+]`
+
+const structureCSV = `number,name,singular_name,abbreviations,chapters
+1,Genesis,,gen;gn,31;25;24
+2,Psalms,Psalm,ps; psalm,6;12
+3,"Song of Songs",,song;canticles,17
+`
+
+const structureYAML = `# a short Bible
+- number: 1
+  name: Genesis
+  abbreviations: [gen, gn]
+  chapters: [31, 25, 24]
+- number: 2
+  name: "Psalms"
+  singular_name: Psalm # one psalm
+  abbreviations:
+    - ps
+    - 'psalm'
+  chapters:
+  - 6
+  - 12
+- number: 3
+  name: Song of Songs
+  abbreviations: [song, canticles]
+  chapters: [17]
+`
+
+func TestLoadStructure(t *testing.T) {
+	want := map[int]data.BookData{
+		1: {Name: "Genesis", SingularName: "Genesis", Abbreviations: []string{"gen", "gn"}, Chapters: []int{31, 25, 24}, ChapterStructure: map[int]int{1: 31, 2: 25, 3: 24}},
+		2: {Name: "Psalms", SingularName: "Psalm", Abbreviations: []string{"ps", "psalm"}, Chapters: []int{6, 12}, ChapterStructure: map[int]int{1: 6, 2: 12}},
+		3: {Name: "Song of Songs", SingularName: "Song of Songs", Abbreviations: []string{"song", "canticles"}, Chapters: []int{17}, ChapterStructure: map[int]int{1: 17}},
+	}
+	for name, in := range map[string]string{"json": structureJSON, "csv": structureCSV, "yaml": structureYAML} {
+		t.Run(name, func(t *testing.T) {
+			got, err := LoadStructure(strings.NewReader(in))
+			if err != nil {
+				t.Fatalf("LoadStructure: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("got %#v\nwant %#v", got, want)
+			}
+		})
+	}
+}
+
+func TestWithStructure(t *testing.T) {
+	structure, err := LoadStructure(strings.NewReader(structureJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewBiblePassageParser(WithStructure(structure))
+	if got := len(p.Books()); got != 3 {
+		t.Fatalf("%d books, want 3", got)
+	}
+	cases := map[string]string{
+		"gn 2:3":                  "Genesis 2:3",
+		"Canticles":               "Song of Songs",
+		"Genesis 3 - Psalms 1":    "Genesis 3 - Psalms 1",
+		"Genesis 3:24 - Song 1:2": "Genesis 3:24 - Song of Songs 1:2",
+		"ps 2":                    "Psalm 2",
+	}
+	for in, want := range cases {
+		got, err := p.Normalise(in)
+		if err != nil || got != want {
+			t.Errorf("Normalise(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"Genesis 4", "John 3:16", "Psalm 2:13"} {
+		if _, err := p.Parse(in); err == nil {
+			t.Errorf("Parse(%q) succeeded with the custom structure, want error", in)
+		}
+	}
+}
+
+func TestLoadStructure_Invalid(t *testing.T) {
+	cases := map[string]string{
+		"empty":                "  ",
+		"bad json":             `[{"number": "one"}]`,
+		"gap in book numbers":  `[{"number": 1, "name": "Genesis", "chapters": [3]}, {"number": 3, "name": "Leviticus", "chapters": [3]}]`,
+		"duplicate book":       `[{"number": 1, "name": "Genesis", "chapters": [3]}, {"number": 1, "name": "Exodus", "chapters": [3]}]`,
+		"no name":              `[{"number": 1, "chapters": [3]}]`,
+		"no chapters":          `[{"number": 1, "name": "Genesis"}]`,
+		"zero verses":          `[{"number": 1, "name": "Genesis", "chapters": [3, 0]}]`,
+		"shared abbreviation":  `[{"number": 1, "name": "Genesis", "abbreviations": ["Ge"], "chapters": [3]}, {"number": 2, "name": "Exodus", "abbreviations": ["ge."], "chapters": [3]}]`,
+		"name is another's":    `[{"number": 1, "name": "Genesis", "chapters": [3]}, {"number": 2, "name": "Exodus", "abbreviations": ["genesis"], "chapters": [3]}]`,
+		"numeric abbreviation": `[{"number": 1, "name": "Genesis", "abbreviations": ["1"], "chapters": [3]}]`,
+		"csv missing column":   "number,name\n1,Genesis\n",
+		"csv bad count":        "number,name,chapters\n1,Genesis,31;x\n",
+		"yaml unknown key":     "- number: 1\n  name: Genesis\n  testament: old\n  chapters: [3]\n",
+		"yaml bad list":        "- number: 1\n  name: Genesis\n  chapters: 3\n",
+		"yaml no dash":         "- number: 1\nname: Genesis\n",
+		"yaml key before book": "# books\n  number: 1\n",
+	}
+	for name, in := range cases {
+		if _, err := LoadStructure(strings.NewReader(in)); err == nil {
+			t.Errorf("%s: LoadStructure succeeded, want error", name)
+		}
+	}
+}
+
+func TestWithStructure_Checked(t *testing.T) {
+	if _, err := NewCheckedBiblePassageParser(); err != nil {
+		t.Fatalf("built-in table: %v", err)
+	}
+	structure, err := LoadStructure(strings.NewReader(structureJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewCheckedBiblePassageParser(WithStructure(structure)); err != nil {
+		t.Fatalf("loaded table: %v", err)
+	}
+
+	cases := map[string]map[int]data.BookData{
+		"chapter gap": {
+			1: {Name: "Genesis", SingularName: "Genesis", ChapterStructure: map[int]int{1: 31, 2: 25, 4: 26}},
+		},
+		"shared abbreviation": {
+			1: {Name: "Genesis", SingularName: "Genesis", Abbreviations: []string{"ge"}, Chapters: []int{31}},
+			2: {Name: "Exodus", SingularName: "Exodus", Abbreviations: []string{"ge"}, Chapters: []int{22}},
+		},
+	}
+	for name, structure := range cases {
+		if _, err := NewCheckedBiblePassageParser(WithStructure(structure)); err == nil {
+			t.Errorf("%s: NewCheckedBiblePassageParser succeeded, want error", name)
+		}
+	}
+}
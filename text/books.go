@@ -0,0 +1,76 @@
+package text
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	parser "github.com/gotedo/bible-chapter-verse-parser"
+)
+
+// osisBooks are the OSIS book identifiers ("Gen.1.1"), indexed by book number - 1.
+var osisBooks = []string{
+	"Gen", "Exod", "Lev", "Num", "Deut", "Josh", "Judg", "Ruth", "1Sam", "2Sam",
+	"1Kgs", "2Kgs", "1Chr", "2Chr", "Ezra", "Neh", "Esth", "Job", "Ps", "Prov",
+	"Eccl", "Song", "Isa", "Jer", "Lam", "Ezek", "Dan", "Hos", "Joel", "Amos",
+	"Obad", "Jonah", "Mic", "Nah", "Hab", "Zeph", "Hag", "Zech", "Mal",
+	"Matt", "Mark", "Luke", "John", "Acts", "Rom", "1Cor", "2Cor", "Gal", "Eph",
+	"Phil", "Col", "1Thess", "2Thess", "1Tim", "2Tim", "Titus", "Phlm", "Heb", "Jas",
+	"1Pet", "2Pet", "1John", "2John", "3John", "Jude", "Rev",
+}
+
+// usfmBooks are the USFM book codes ("\id GEN"), indexed by book number - 1.
+var usfmBooks = []string{
+	"GEN", "EXO", "LEV", "NUM", "DEU", "JOS", "JDG", "RUT", "1SA", "2SA",
+	"1KI", "2KI", "1CH", "2CH", "EZR", "NEH", "EST", "JOB", "PSA", "PRO",
+	"ECC", "SNG", "ISA", "JER", "LAM", "EZK", "DAN", "HOS", "JOL", "AMO",
+	"OBA", "JON", "MIC", "NAM", "HAB", "ZEP", "HAG", "ZEC", "MAL",
+	"MAT", "MRK", "LUK", "JHN", "ACT", "ROM", "1CO", "2CO", "GAL", "EPH",
+	"PHP", "COL", "1TH", "2TH", "1TI", "2TI", "TIT", "PHM", "HEB", "JAS",
+	"1PE", "2PE", "1JN", "2JN", "3JN", "JUD", "REV",
+}
+
+func bookNumber(codes []string, code string) (int, bool) {
+	for i, c := range codes {
+		if strings.EqualFold(c, code) {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+var (
+	namesOnce sync.Once
+	names     *parser.BiblePassageParser
+)
+
+// bookByName accepts a book number ("43") or any name or abbreviation the parser
+// knows ("John", "Jn").
+func bookByName(name string) (int, bool) {
+	if n, err := strconv.Atoi(strings.TrimSpace(name)); err == nil {
+		return n, true
+	}
+	namesOnce.Do(func() { names = parser.NewBiblePassageParser() })
+	b, err := names.Book(name)
+	if err != nil {
+		return 0, false
+	}
+	return b.Number, true
+}
+
+var (
+	booksOnce sync.Once
+	books     []*parser.Book
+)
+
+// book returns the canonical book with the given number, or nil.
+func book(number int) *parser.Book {
+	booksOnce.Do(func() {
+		namesOnce.Do(func() { names = parser.NewBiblePassageParser() })
+		books = names.Books()
+	})
+	if number < 1 || number > len(books) {
+		return nil
+	}
+	return books[number-1]
+}
@@ -0,0 +1,194 @@
+package text
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	parser "github.com/gotedo/bible-chapter-verse-parser"
+)
+
+// Scope restricts a search. Both *parser.BiblePassage and *parser.PassageSet are
+// scopes, e.g. the passage "Romans" to find "grace" within Romans only.
+type Scope interface {
+	Contains(ref *parser.BibleReference) bool
+}
+
+// Span is a highlighted part of a verse: Text[Start:End].
+type Span struct {
+	Start int
+	End   int
+}
+
+// Hit is a verse matching a search, with every matched word highlighted.
+type Hit struct {
+	Reference  *parser.BibleReference
+	Text       string
+	Highlights []Span
+}
+
+type indexedVerse struct {
+	ref   *parser.BibleReference
+	text  string
+	words []string
+	spans []Span
+}
+
+// Index is an in-memory inverted index over the words of a Bible, matching words
+// regardless of case. It is safe for concurrent searches.
+type Index struct {
+	verses   []indexedVerse
+	postings map[string][]int // word -> indexes into verses, ascending
+}
+
+// NewIndex indexes every verse of b. Verses of books outside the canon are left out.
+func NewIndex(b *Bible) *Index {
+	keys := make([]key, 0, len(b.verses))
+	for k := range b.verses {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.book != b.book {
+			return a.book < b.book
+		}
+		if a.chapter != b.chapter {
+			return a.chapter < b.chapter
+		}
+		return a.verse < b.verse
+	})
+
+	ix := &Index{postings: map[string][]int{}}
+	for _, k := range keys {
+		ref := reference(k)
+		if ref == nil {
+			continue
+		}
+		v := indexedVerse{ref: ref, text: b.verses[k]}
+		v.words, v.spans = words(v.text)
+		n := len(ix.verses)
+		for _, w := range v.words {
+			if p := ix.postings[w]; len(p) == 0 || p[len(p)-1] != n {
+				ix.postings[w] = append(p, n)
+			}
+		}
+		ix.verses = append(ix.verses, v)
+	}
+	return ix
+}
+
+func reference(k key) *parser.BibleReference {
+	b := book(k.book)
+	if b == nil {
+		return nil
+	}
+	if k.verse == 0 {
+		ref, _ := parser.NewSuperscriptionReference(b, k.chapter)
+		return ref
+	}
+	return &parser.BibleReference{Book: b, Chapter: k.chapter, Verse: k.verse}
+}
+
+// Search returns the verses containing every word and phrase of query, in
+// canonical order. Phrases are quoted: `grace "through faith"`. A nil scope
+// searches the whole Bible.
+func (ix *Index) Search(query string, scope Scope) ([]Hit, error) {
+	terms := parseQuery(query)
+	if len(terms) == 0 {
+		return nil, errors.New("text: empty query")
+	}
+
+	// candidates are the verses holding the rarest word of the query
+	candidates := ix.postings[terms[0][0]]
+	for _, term := range terms {
+		for _, w := range term {
+			if p := ix.postings[w]; len(p) < len(candidates) {
+				candidates = p
+			}
+		}
+	}
+
+	hits := []Hit{}
+	for _, i := range candidates {
+		v := ix.verses[i]
+		if scope != nil && !scope.Contains(v.ref) {
+			continue
+		}
+		if highlights, ok := v.match(terms); ok {
+			hits = append(hits, Hit{Reference: v.ref, Text: v.text, Highlights: highlights})
+		}
+	}
+	return hits, nil
+}
+
+// match finds every occurrence of each term (a word or a phrase) in the verse, and
+// reports whether all terms occur.
+func (v indexedVerse) match(terms [][]string) ([]Span, bool) {
+	highlights := []Span{}
+	for _, term := range terms {
+		found := false
+		for i := 0; i+len(term) <= len(v.words); i++ {
+			if equalWords(v.words[i:i+len(term)], term) {
+				found = true
+				highlights = append(highlights, Span{v.spans[i].Start, v.spans[i+len(term)-1].End})
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	sort.Slice(highlights, func(i, j int) bool { return highlights[i].Start < highlights[j].Start })
+	return highlights, true
+}
+
+func equalWords(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseQuery splits a query into terms: single words, or the words of a quoted phrase.
+func parseQuery(query string) [][]string {
+	terms := [][]string{}
+	for i, part := range strings.Split(query, `"`) {
+		ws, _ := words(part)
+		if i%2 == 1 {
+			if len(ws) > 0 {
+				terms = append(terms, ws)
+			}
+			continue
+		}
+		for _, w := range ws {
+			terms = append(terms, []string{w})
+		}
+	}
+	return terms
+}
+
+// words splits s into lower-case words of letters and digits, with their spans.
+func words(s string) ([]string, []Span) {
+	ws, spans := []string{}, []Span{}
+	start := -1
+	for i := 0; i <= len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if i < len(s) && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			if start < 0 {
+				start = i
+			}
+		} else if start >= 0 {
+			ws = append(ws, strings.ToLower(s[start:i]))
+			spans = append(spans, Span{start, i})
+			start = -1
+		}
+		if i == len(s) {
+			break
+		}
+		i += size
+	}
+	return ws, spans
+}
@@ -0,0 +1,71 @@
+package text
+
+import (
+	"reflect"
+	"testing"
+
+	parser "github.com/gotedo/bible-chapter-verse-parser"
+)
+
+func searchBible() *Bible {
+	b := NewBible()
+	b.Add(45, 3, 24, "Being justified freely by his grace through the redemption that is in Christ Jesus:")
+	b.Add(45, 5, 2, "By whom also we have access by faith into this grace wherein we stand.")
+	b.Add(49, 2, 8, "For by grace are ye saved through faith; and that not of yourselves: it is the gift of God:")
+	b.Add(43, 1, 14, "And the Word was made flesh, and dwelt among us, full of grace and truth.")
+	b.Add(19, 51, 0, "To the chief Musician, A Psalm of David.")
+	b.Add(19, 51, 1, "Have mercy upon me, O God, according to thy lovingkindness.")
+	return b
+}
+
+func TestIndex_Search(t *testing.T) {
+	ix := NewIndex(searchBible())
+	p := parser.NewBiblePassageParser()
+	romans, _ := p.Parse("Romans")
+	gospels, _ := p.Parse("Matt - John; Eph 2")
+
+	cases := []struct {
+		name  string
+		query string
+		scope Scope
+		want  []string
+	}{
+		{"word in canonical order", "grace", nil, []string{"John 1:14", "Romans 3:24", "Romans 5:2", "Ephesians 2:8"}},
+		{"case-insensitive", "GRACE Through", nil, []string{"Romans 3:24", "Ephesians 2:8"}},
+		{"phrase", `"through faith"`, nil, []string{"Ephesians 2:8"}},
+		{"phrase and word", `"by grace" saved`, nil, []string{"Ephesians 2:8"}},
+		{"words out of order are not a phrase", `"faith through"`, nil, []string{}},
+		{"unknown word", "grace zebra", nil, []string{}},
+		{"within a passage", "grace", romans[0], []string{"Romans 3:24", "Romans 5:2"}},
+		{"within a passage set", "grace", parser.NewPassageSet(gospels...), []string{"John 1:14", "Ephesians 2:8"}},
+		{"psalm title", "musician", nil, []string{"Psalms 51:title"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			hits, err := ix.Search(c.query, c.scope)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := []string{}
+			for _, h := range hits {
+				got = append(got, h.Reference.String())
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %q, want %q", got, c.want)
+			}
+		})
+	}
+
+	hits, _ := ix.Search(`"by grace" faith`, nil)
+	var marked []string
+	for _, s := range hits[0].Highlights {
+		marked = append(marked, hits[0].Text[s.Start:s.End])
+	}
+	if want := []string{"by grace", "faith"}; !reflect.DeepEqual(marked, want) {
+		t.Errorf("highlights %q, want %q", marked, want)
+	}
+
+	if _, err := ix.Search(` "" `, nil); err == nil {
+		t.Error("expected error for an empty query")
+	}
+}
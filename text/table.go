@@ -0,0 +1,80 @@
+package text
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// LoadJSON reads an array of verses:
+//
+//	[{"book": "Genesis", "chapter": 1, "verse": 1, "text": "In the beginning ..."}]
+//
+// The book is a number or any name or abbreviation the parser knows.
+func LoadJSON(r io.Reader) (*Bible, error) {
+	var rows []struct {
+		Book    json.RawMessage `json:"book"`
+		Chapter int             `json:"chapter"`
+		Verse   int             `json:"verse"`
+		Text    string          `json:"text"`
+	}
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, fmt.Errorf("text: %w", err)
+	}
+	b := NewBible()
+	unknown := map[string]bool{}
+	for _, row := range rows {
+		var name string
+		if err := json.Unmarshal(row.Book, &name); err != nil {
+			name = string(row.Book)
+		}
+		b.addRow(name, row.Chapter, row.Verse, row.Text, unknown)
+	}
+	return b, nil
+}
+
+// LoadCSV reads rows of book, chapter, verse and text, with an optional header row.
+// The book is a number or any name or abbreviation the parser knows.
+func LoadCSV(r io.Reader) (*Bible, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 4
+	b := NewBible()
+	unknown := map[string]bool{}
+	for line := 1; ; line++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("text: %w", err)
+		}
+		chapter, err := strconv.Atoi(strings.TrimSpace(rec[1]))
+		if err != nil && line == 1 {
+			continue // header
+		}
+		if err != nil {
+			return nil, fmt.Errorf("text: line %d: invalid chapter %q", line, rec[1])
+		}
+		verse, err := strconv.Atoi(strings.TrimSpace(rec[2]))
+		if err != nil {
+			return nil, fmt.Errorf("text: line %d: invalid verse %q", line, rec[2])
+		}
+		b.addRow(rec[0], chapter, verse, rec[3], unknown)
+	}
+	return b, nil
+}
+
+func (b *Bible) addRow(name string, chapter, verse int, text string, unknown map[string]bool) {
+	book, ok := bookByName(name)
+	if !ok {
+		if !unknown[name] {
+			unknown[name] = true
+			b.unknown = append(b.unknown, name)
+		}
+		return
+	}
+	b.Add(book, chapter, verse, normaliseSpace(text))
+}
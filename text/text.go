@@ -0,0 +1,185 @@
+// Package text looks up the words of the passages found by the parser.
+//
+// A Provider returns the verses of a passage. Bible is an in-memory Provider that
+// can be loaded from local files: OSIS XML, Zefania XML, a directory of USFM files,
+// or a simple JSON or CSV table of book, chapter, verse and text.
+package text
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	parser "github.com/gotedo/bible-chapter-verse-parser"
+	"github.com/gotedo/bible-chapter-verse-parser/data"
+)
+
+// Verse is the text of one verse. Reference comes from BiblePassage.Verses, so the
+// first and last verse of a passage may carry a fragment; Text is still the whole
+// verse. A Psalm title has Reference.Superscription set.
+type Verse struct {
+	Reference *parser.BibleReference
+	Text      string
+}
+
+// Provider returns the verses of a passage in order.
+type Provider interface {
+	Text(ctx context.Context, passage *parser.BiblePassage) ([]Verse, error)
+}
+
+type key struct {
+	book, chapter, verse int
+}
+
+// Bible is a Provider holding a whole translation in memory. Verse 0 of a chapter
+// holds a Psalm title. A Bible is safe for concurrent reads once loaded.
+type Bible struct {
+	verses map[key]string
+	// unknown lists the book identifiers of the source that are not in the canon.
+	unknown []string
+}
+
+func NewBible() *Bible {
+	return &Bible{verses: map[key]string{}}
+}
+
+// Add sets the text of a verse; verse 0 is the title of a Psalm.
+func (b *Bible) Add(book, chapter, verse int, text string) {
+	b.verses[key{book, chapter, verse}] = text
+}
+
+// Len is the number of verses (and titles) held.
+func (b *Bible) Len() int {
+	return len(b.verses)
+}
+
+// Text returns the verses of passage. A verse missing from the Bible is an error.
+func (b *Bible) Text(ctx context.Context, passage *parser.BiblePassage) ([]Verse, error) {
+	refs := passage.Verses()
+	verses := make([]Verse, 0, len(refs))
+	for i, ref := range refs {
+		if i%256 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		text, ok := b.verses[key{ref.Book.Number, ref.Chapter, ref.Verse}]
+		if !ok {
+			return nil, fmt.Errorf("text: %s is missing", ref)
+		}
+		verses = append(verses, Verse{Reference: ref, Text: text})
+	}
+	return verses, nil
+}
+
+// Mismatch is a difference between a loaded Bible and data.BibleStructure. Chapter
+// and Verse are zero when the mismatch concerns a whole book or chapter.
+type Mismatch struct {
+	Book    int
+	Chapter int
+	Verse   int
+	Message string
+}
+
+func (m Mismatch) String() string {
+	return m.Message
+}
+
+// Check compares the Bible with data.BibleStructure and reports every book the
+// source names that is not in the canon, and every missing or extra chapter and
+// verse of the books it contains. Books absent altogether are not reported, so a
+// New Testament alone checks clean. Psalm titles are not checked.
+func (b *Bible) Check() []Mismatch {
+	mismatches := []Mismatch{}
+	for _, name := range b.unknown {
+		mismatches = append(mismatches, Mismatch{Message: fmt.Sprintf("unknown book %q", name)})
+	}
+
+	have := map[int]map[int]map[int]bool{}
+	for k := range b.verses {
+		if k.verse == 0 {
+			continue
+		}
+		if have[k.book] == nil {
+			have[k.book] = map[int]map[int]bool{}
+		}
+		if have[k.book][k.chapter] == nil {
+			have[k.book][k.chapter] = map[int]bool{}
+		}
+		have[k.book][k.chapter][k.verse] = true
+	}
+
+	for _, book := range sortedKeys(have) {
+		bd, ok := data.BibleStructure[book]
+		if !ok {
+			mismatches = append(mismatches, Mismatch{Book: book, Message: fmt.Sprintf("unknown book %d", book)})
+			continue
+		}
+		chapters := have[book]
+		for ch := 1; ch <= len(bd.ChapterStructure); ch++ {
+			verses, ok := chapters[ch]
+			if !ok {
+				mismatches = append(mismatches, Mismatch{Book: book, Chapter: ch, Message: fmt.Sprintf("%s %d is missing", bd.Name, ch)})
+				continue
+			}
+			for v := 1; v <= bd.ChapterStructure[ch]; v++ {
+				if !verses[v] {
+					mismatches = append(mismatches, Mismatch{Book: book, Chapter: ch, Verse: v, Message: fmt.Sprintf("%s %d:%d is missing", bd.Name, ch, v)})
+				}
+			}
+			for _, v := range sortedKeys(verses) {
+				if v > bd.ChapterStructure[ch] {
+					mismatches = append(mismatches, Mismatch{Book: book, Chapter: ch, Verse: v, Message: fmt.Sprintf("%s %d:%d is not in the canon", bd.Name, ch, v)})
+				}
+			}
+		}
+		for _, ch := range sortedKeys(chapters) {
+			if _, ok := bd.ChapterStructure[ch]; !ok {
+				mismatches = append(mismatches, Mismatch{Book: book, Chapter: ch, Message: fmt.Sprintf("%s %d is not in the canon", bd.Name, ch)})
+			}
+		}
+	}
+	return mismatches
+}
+
+func sortedKeys[V any](m map[int]V) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// Open loads a Bible from path, choosing the format from it: a directory is read
+// as USFM files, ".json" and ".csv" files as tables, and XML files as OSIS or
+// Zefania depending on their root element.
+func Open(path string) (*Bible, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return LoadUSFM(os.DirFS(path))
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return LoadJSON(f)
+	case ".csv":
+		return LoadCSV(f)
+	}
+	return LoadXML(f)
+}
+
+// normaliseSpace collapses runs of white space and trims the ends.
+func normaliseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
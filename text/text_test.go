@@ -0,0 +1,219 @@
+package text
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	parser "github.com/gotedo/bible-chapter-verse-parser"
+)
+
+var p = parser.NewBiblePassageParser()
+
+func passage(t *testing.T, s string) *parser.BiblePassage {
+	t.Helper()
+	got, err := p.Parse(s)
+	if err != nil {
+		t.Fatalf("parse %q: %v", s, err)
+	}
+	return got[0]
+}
+
+func texts(t *testing.T, b *Bible, s string) []string {
+	t.Helper()
+	verses, err := b.Text(context.Background(), passage(t, s))
+	if err != nil {
+		t.Fatalf("text of %q: %v", s, err)
+	}
+	out := make([]string, len(verses))
+	for i, v := range verses {
+		out[i] = v.Reference.String() + " " + v.Text
+	}
+	return out
+}
+
+const osisDoc = `<?xml version="1.0" encoding="UTF-8"?>
+<osis xmlns="http://www.bibletechnologies.net/2003/OSIS/namespace"><osisText><div type="book" osisID="Ps">
+<chapter osisID="Ps.3">
+<title type="psalm" canonical="true">A Psalm of David, when he fled from Absalom his son.</title>
+<verse osisID="Ps.3.1">LORD, how are they increased<note>Or, multiplied</note> that trouble me!</verse>
+<verse osisID="Ps.3.2">Many there be which say of my soul,</verse>
+</chapter>
+<chapter sID="Ps.4" osisID="Ps.4"/>
+<title type="sub">Heading</title>
+<verse sID="Ps.4.1" osisID="Ps.4.1"/>Hear me when I call,
+  O God of my righteousness:<verse eID="Ps.4.1"/>
+<verse sID="Ps.4.2" osisID="Ps.4.2 Ps.4.3"/>O ye sons of men<verse eID="Ps.4.2"/>
+<chapter eID="Ps.4"/>
+</div><div type="book" osisID="Tob"><verse osisID="Tob.1.1">The book of the words of Tobit</verse></div></osisText></osis>`
+
+func TestLoadOSIS(t *testing.T) {
+	b, err := LoadXML(strings.NewReader(osisDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := texts(t, b, "Psalm 3:title-2")
+	want := []string{
+		"Psalms 3:title A Psalm of David, when he fled from Absalom his son.",
+		"Psalms 3:1 LORD, how are they increased that trouble me!",
+		"Psalms 3:2 Many there be which say of my soul,",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+	got = texts(t, b, "Psalm 4:1-3")
+	want = []string{
+		"Psalms 4:1 Hear me when I call, O God of my righteousness:",
+		"Psalms 4:2 O ye sons of men",
+		"Psalms 4:3 ",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+	if m := b.Check(); len(m) == 0 || m[0].Message != `unknown book "Tob"` {
+		t.Errorf("check: %v", m)
+	}
+}
+
+const zefaniaDoc = `<?xml version="1.0"?>
+<XMLBIBLE biblename="Test">
+<BIBLEBOOK bnumber="65" bname="Jude">
+<CHAPTER cnumber="1">
+<CAPTION vref="1">Greeting</CAPTION>
+<VERS vnumber="1">Jude, the servant of Jesus Christ,<NOTE>Or, Judas</NOTE> and brother of James,</VERS>
+<VERS vnumber="2">Mercy unto you, and peace, and love, be multiplied.</VERS>
+</CHAPTER>
+</BIBLEBOOK>
+<BIBLEBOOK bnumber="67" bname="Tobit"><CHAPTER cnumber="1"><VERS vnumber="1">Tobit</VERS></CHAPTER></BIBLEBOOK>
+</XMLBIBLE>`
+
+func TestLoadZefania(t *testing.T) {
+	b, err := LoadXML(strings.NewReader(zefaniaDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := texts(t, b, "Jude 1:1-2")
+	want := []string{
+		"Jude 1:1 Jude, the servant of Jesus Christ, and brother of James,",
+		"Jude 1:2 Mercy unto you, and peace, and love, be multiplied.",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+	m := b.Check()
+	if len(m) != 24 || m[0].Message != `unknown book "Tobit"` || m[1].Message != "Jude 1:3 is missing" {
+		t.Errorf("check: %d mismatches, first %v", len(m), m[:2])
+	}
+}
+
+func TestLoadUSFM(t *testing.T) {
+	fsys := fstest.MapFS{
+		"19PSA.usfm": {Data: []byte(`\id PSA Psalms
+\h Psalms
+\c 3
+\d A Psalm of David, when he fled from Absalom his son.
+\q1
+\v 1 LORD, how are they increased that trouble me!\f + \fr 3:1 \ft Or, multiplied\f*
+\q2 many are they that rise up against me.
+\s1 A heading
+\v 2 \w Many|strong="H7227"\w* there be which say of my soul,
+`)},
+		"43JHN.SFM": {Data: []byte(`\id JHN
+\c 3
+\p
+\v 16 For God so loved the world,\x - \xo 3:16 \xt Rom 5:8\x* that he gave his only begotten Son,
+\v 17-18 For God sent not his Son into the world to condemn the world;
+`)},
+		"notes.txt": {Data: []byte("not a book")},
+	}
+	b, err := LoadUSFM(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := texts(t, b, "Psalm 3:title-2")
+	want := []string{
+		"Psalms 3:title A Psalm of David, when he fled from Absalom his son.",
+		"Psalms 3:1 LORD, how are they increased that trouble me! many are they that rise up against me.",
+		"Psalms 3:2 Many there be which say of my soul,",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+	got = texts(t, b, "John 3:16-18")
+	want = []string{
+		"John 3:16 For God so loved the world, that he gave his only begotten Son,",
+		"John 3:17 For God sent not his Son into the world to condemn the world;",
+		"John 3:18 ",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+}
+
+func TestLoadTables(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"bible.json": `[{"book": "Gen", "chapter": 1, "verse": 1, "text": "In the beginning"},
+			{"book": 1, "chapter": 1, "verse": 2, "text": "And the earth"},
+			{"book": "Wisdom", "chapter": 1, "verse": 1, "text": "Love righteousness"}]`,
+		"bible.csv": "book,chapter,verse,text\nGenesis,1,1,In the beginning\n1,1,2,\"And the earth\"\nWisdom,1,1,Love righteousness\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		b, err := Open(path)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		got := texts(t, b, "Gen 1:1-2")
+		want := []string{"Genesis 1:1 In the beginning", "Genesis 1:2 And the earth"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %q\nwant %q", name, got, want)
+		}
+		if m := b.Check(); m[0].Message != `unknown book "Wisdom"` || m[1].Message != "Genesis 1:3 is missing" {
+			t.Errorf("%s: check %v", name, m[:2])
+		}
+	}
+
+	if _, err := LoadCSV(strings.NewReader("Genesis,1,x,In the beginning\n")); err == nil {
+		t.Error("expected error for an invalid verse number")
+	}
+}
+
+func TestBible_Text(t *testing.T) {
+	b := NewBible()
+	b.Add(43, 3, 36, "He that believeth on the Son")
+	b.Add(43, 4, 1, "When therefore the Lord knew")
+	got := texts(t, b, "John 3:36-4:1")
+	want := []string{"John 3:36 He that believeth on the Son", "John 4:1 When therefore the Lord knew"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+
+	if _, err := b.Text(context.Background(), passage(t, "John 4:1-2")); err == nil || err.Error() != "text: John 4:2 is missing" {
+		t.Errorf("got %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := b.Text(ctx, passage(t, "John 3:36")); err != context.Canceled {
+		t.Errorf("got %v", err)
+	}
+
+	b.Add(43, 3, 37, "extra")
+	b.Add(43, 22, 1, "extra")
+	var extra []string
+	for _, m := range b.Check() {
+		if strings.Contains(m.Message, "not in the canon") {
+			extra = append(extra, m.Message)
+		}
+	}
+	if want := []string{"John 3:37 is not in the canon", "John 22 is not in the canon"}; !reflect.DeepEqual(extra, want) {
+		t.Errorf("got %q", extra)
+	}
+}
@@ -0,0 +1,179 @@
+package text
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	usfmMarker = regexp.MustCompile(`\\(\+?[A-Za-z]+[0-9]*)(\*?)`)
+	// usfmAttributes are the word attributes of "\w grace|strong="G5485"\w*".
+	usfmAttributes = regexp.MustCompile(`\|[^\\]*`)
+	usfmNumber     = regexp.MustCompile(`^\s*(\d+)(?:[a-z]?-(\d+))?[a-z]?`)
+)
+
+// usfmLineMarkers introduce text that is not part of any verse and runs to the end
+// of the line: identification, titles, headings and remarks.
+var usfmLineMarkers = map[string]bool{
+	"ide": true, "h": true, "toc": true, "toca": true, "mt": true, "mte": true, "ms": true, "mr": true,
+	"s": true, "sr": true, "r": true, "rem": true, "sts": true, "cl": true, "cd": true, "sp": true,
+	"usfm": true, "restore": true,
+}
+
+// usfmNotes are the spans left out up to their closing marker ("\f ... \f*"):
+// footnotes, cross references, figures and alternative numbering.
+var usfmNotes = map[string]bool{
+	"f": true, "fe": true, "x": true, "fig": true, "ca": true, "va": true, "vp": true,
+}
+
+// LoadUSFM reads every .usfm and .sfm file at the root of fsys, one book per file
+// as named by its \id marker. Footnotes, cross references and headings are left
+// out; a \d line (the title of a Psalm) becomes verse 0 of its psalm.
+func LoadUSFM(fsys fs.FS) (*Bible, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	b := NewBible()
+	for _, e := range entries {
+		if ext := strings.ToLower(path.Ext(e.Name())); e.IsDir() || (ext != ".usfm" && ext != ".sfm") {
+			continue
+		}
+		content, err := fs.ReadFile(fsys, e.Name())
+		if err != nil {
+			return nil, err
+		}
+		if err := parseUSFM(b, string(content)); err != nil {
+			return nil, fmt.Errorf("text: %s: %w", e.Name(), err)
+		}
+	}
+	return b, nil
+}
+
+func parseUSFM(b *Bible, content string) error {
+	content = usfmAttributes.ReplaceAllString(content, "")
+
+	var (
+		book, chapter int
+		current       []key
+		text          strings.Builder
+		skip          string // the note being left out
+		drop          bool   // leave out the rest of the line
+	)
+	flush := func() {
+		for i, k := range current {
+			if i == 0 {
+				b.Add(k.book, k.chapter, k.verse, normaliseSpace(text.String()))
+			} else {
+				b.Add(k.book, k.chapter, k.verse, "")
+			}
+		}
+		current = nil
+		text.Reset()
+	}
+	write := func(s string) {
+		if skip != "" {
+			return
+		}
+		if drop {
+			i := strings.IndexByte(s, '\n')
+			if i < 0 {
+				return
+			}
+			drop = false
+			s = s[i+1:]
+		}
+		if len(current) > 0 {
+			text.WriteString(s)
+		}
+	}
+	// number reads the chapter or verse number following a marker, e.g. "3" or "1-2".
+	number := func(pos int) (int, int, int, error) {
+		m := usfmNumber.FindStringSubmatchIndex(content[pos:])
+		if m == nil {
+			return 0, 0, pos, fmt.Errorf("missing number at byte %d", pos)
+		}
+		from, _ := strconv.Atoi(content[pos+m[2] : pos+m[3]])
+		to := from
+		if m[4] >= 0 {
+			to, _ = strconv.Atoi(content[pos+m[4] : pos+m[5]])
+		}
+		return from, to, pos + m[1], nil
+	}
+
+	pos := 0
+	for _, loc := range usfmMarker.FindAllStringSubmatchIndex(content, -1) {
+		if loc[0] < pos {
+			continue
+		}
+		write(content[pos:loc[0]])
+		pos = loc[1]
+		name := strings.TrimPrefix(content[loc[2]:loc[3]], "+")
+		closing := loc[5] > loc[4]
+		if skip != "" {
+			if closing && name == skip {
+				skip = ""
+			}
+			continue
+		}
+		if closing {
+			continue
+		}
+		switch base := strings.TrimRight(name, "0123456789"); {
+		case usfmNotes[base]:
+			skip = name
+		case name == "id":
+			flush()
+			code := ""
+			if fields := strings.Fields(content[pos:min(len(content), pos+16)]); len(fields) > 0 {
+				code = fields[0]
+			}
+			n, ok := bookNumber(usfmBooks, code)
+			if !ok {
+				b.unknown = append(b.unknown, code)
+			}
+			book, chapter = n, 0
+			drop = true
+		case name == "c":
+			flush()
+			n, _, next, err := number(pos)
+			if err != nil {
+				return err
+			}
+			chapter, pos = n, next
+		case name == "v":
+			flush()
+			from, to, next, err := number(pos)
+			if err != nil {
+				return err
+			}
+			pos = next
+			if book != 0 {
+				for v := from; v <= to; v++ {
+					current = append(current, key{book, chapter, v})
+				}
+			}
+		case name == "d":
+			flush()
+			if book != 0 {
+				current = []key{{book, chapter, 0}}
+			}
+		case usfmLineMarkers[base]:
+			drop = true
+		}
+	}
+	write(content[pos:])
+	flush()
+	return nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
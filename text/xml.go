@@ -0,0 +1,257 @@
+package text
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// LoadXML reads OSIS or Zefania XML, depending on the root element.
+func LoadXML(r io.Reader) (*Bible, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	dec := xml.NewDecoder(bytes.NewReader(content))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("text: no root element: %w", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			switch strings.ToLower(start.Name.Local) {
+			case "osis":
+				return LoadOSIS(bytes.NewReader(content))
+			case "xmlbible":
+				return LoadZefania(bytes.NewReader(content))
+			}
+			return nil, fmt.Errorf("text: unknown XML format <%s>", start.Name.Local)
+		}
+	}
+}
+
+// LoadOSIS reads an OSIS XML document. Verses may be containers
+// (<verse osisID="Gen.1.1">...</verse>) or milestones (<verse sID="..."/> ...
+// <verse eID="..."/>). Notes and headings are left out; canonical Psalm titles
+// (<title type="psalm">) become verse 0 of their psalm.
+func LoadOSIS(r io.Reader) (*Bible, error) {
+	b := NewBible()
+	unknown := map[string]bool{}
+	dec := xml.NewDecoder(r)
+
+	var (
+		current  []key // the verse being read; several for combined verses
+		text     strings.Builder
+		chapter  key
+		inTitle  bool
+		skip     int
+		verseTag []bool // per open <verse>: whether its end element closes the verse
+	)
+	flush := func() {
+		for i, k := range current {
+			if i == 0 {
+				b.Add(k.book, k.chapter, k.verse, normaliseSpace(text.String()))
+			} else if _, ok := b.verses[k]; !ok {
+				b.Add(k.book, k.chapter, k.verse, "")
+			}
+		}
+		current = nil
+		text.Reset()
+	}
+	parseIDs := func(ids string) []key {
+		keys := []key{}
+		for _, id := range strings.Fields(ids) {
+			k, ok := osisKey(id)
+			if !ok {
+				if code := strings.SplitN(id, ".", 2)[0]; !unknown[code] {
+					unknown[code] = true
+					b.unknown = append(b.unknown, code)
+				}
+				continue
+			}
+			keys = append(keys, k)
+		}
+		return keys
+	}
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("text: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if skip > 0 {
+				skip++
+				continue
+			}
+			switch t.Name.Local {
+			case "verse":
+				if attr(t, "eID") != "" {
+					flush()
+					verseTag = append(verseTag, false)
+					continue
+				}
+				flush()
+				ids := attr(t, "osisID")
+				if ids == "" {
+					ids = attr(t, "sID")
+				}
+				current = parseIDs(ids)
+				verseTag = append(verseTag, attr(t, "sID") == "")
+			case "chapter":
+				if keys := parseIDs(attr(t, "osisID") + " " + attr(t, "sID")); len(keys) > 0 {
+					chapter = keys[0]
+				}
+			case "title":
+				if attr(t, "type") == "psalm" && chapter.book != 0 {
+					flush()
+					current = []key{{chapter.book, chapter.chapter, 0}}
+					inTitle = true
+				} else {
+					skip = 1
+				}
+			case "note":
+				skip = 1
+			}
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+			switch t.Name.Local {
+			case "verse":
+				if n := len(verseTag); n > 0 {
+					if verseTag[n-1] {
+						flush()
+					}
+					verseTag = verseTag[:n-1]
+				}
+			case "title":
+				if inTitle {
+					flush()
+					inTitle = false
+				}
+			}
+		case xml.CharData:
+			if skip == 0 && len(current) > 0 {
+				text.Write(t)
+			}
+		}
+	}
+	flush()
+	return b, nil
+}
+
+// osisKey reads "Gen.1.1" (or "Ps.3" for a chapter).
+func osisKey(id string) (key, bool) {
+	parts := strings.Split(id, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return key{}, false
+	}
+	book, ok := bookNumber(osisBooks, parts[0])
+	if !ok {
+		return key{}, false
+	}
+	k := key{book: book}
+	var err error
+	if k.chapter, err = strconv.Atoi(parts[1]); err != nil {
+		return key{}, false
+	}
+	if len(parts) == 3 {
+		if k.verse, err = strconv.Atoi(parts[2]); err != nil {
+			return key{}, false
+		}
+	}
+	return k, true
+}
+
+// LoadZefania reads a Zefania XML Bible (<XMLBIBLE><BIBLEBOOK bnumber="1">
+// <CHAPTER cnumber="1"><VERS vnumber="1">). Notes are left out. Books numbered
+// beyond the canon, such as the Apocrypha, are reported by Check.
+func LoadZefania(r io.Reader) (*Bible, error) {
+	b := NewBible()
+	dec := xml.NewDecoder(r)
+
+	var (
+		book, chapter int
+		current       *key
+		text          strings.Builder
+		skip          int
+	)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("text: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if skip > 0 {
+				skip++
+				continue
+			}
+			switch strings.ToUpper(t.Name.Local) {
+			case "BIBLEBOOK":
+				n, err := strconv.Atoi(attr(t, "bnumber"))
+				if err != nil || n < 1 || n > len(osisBooks) {
+					name := attr(t, "bname")
+					if name == "" {
+						name = attr(t, "bnumber")
+					}
+					b.unknown = append(b.unknown, name)
+					n = 0
+				}
+				book = n
+			case "CHAPTER":
+				n, err := strconv.Atoi(attr(t, "cnumber"))
+				if err != nil {
+					return nil, fmt.Errorf("text: invalid chapter number %q", attr(t, "cnumber"))
+				}
+				chapter = n
+			case "VERS":
+				n, err := strconv.Atoi(attr(t, "vnumber"))
+				if err != nil {
+					return nil, fmt.Errorf("text: invalid verse number %q", attr(t, "vnumber"))
+				}
+				if book != 0 {
+					current = &key{book, chapter, n}
+				}
+			case "NOTE", "CAPTION", "REMARK", "XREF":
+				skip = 1
+			}
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+			if strings.ToUpper(t.Name.Local) == "VERS" && current != nil {
+				b.Add(current.book, current.chapter, current.verse, normaliseSpace(text.String()))
+				current = nil
+				text.Reset()
+			}
+		case xml.CharData:
+			if skip == 0 && current != nil {
+				text.Write(t)
+			}
+		}
+	}
+	return b, nil
+}
+
+func attr(e xml.StartElement, name string) string {
+	for _, a := range e.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
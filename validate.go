@@ -0,0 +1,143 @@
+package parser
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+)
+
+// Versification identifies a chapter and verse numbering by its totals and a
+// checksum of the verse count of every chapter, in book order.
+type Versification struct {
+	Name     string
+	Books    int
+	Chapters int
+	Verses   int
+	Checksum uint32
+}
+
+// KnownVersifications are the numberings to pass to Validate. The built-in table
+// is the modern English numbering; the KJV differs only in having 14 verses in
+// 3 John.
+var KnownVersifications = []Versification{
+	{Name: "English", Books: 66, Chapters: 1189, Verses: 31103, Checksum: 0x1e8bdcfa},
+	{Name: "English (KJV)", Books: 66, Chapters: 1189, Verses: 31102, Checksum: 0x3a9e1be9},
+}
+
+// Versification returns the totals and checksum of the parser's book table. Name
+// is set when it matches one of KnownVersifications.
+func (p *BiblePassageParser) Versification() Versification {
+	v := Versification{Books: len(p.books)}
+	h := fnv.New32a()
+	var buf [4]byte
+	for _, b := range p.Books() {
+		for ch := 1; ch <= b.ChaptersInBook(); ch++ {
+			n, _ := b.VersesInChapter(ch)
+			v.Chapters++
+			v.Verses += n
+			binary.LittleEndian.PutUint32(buf[:], uint32(n))
+			h.Write(buf[:])
+		}
+		h.Write([]byte{0}) // end of book
+	}
+	v.Checksum = h.Sum32()
+	for _, known := range KnownVersifications {
+		if known.Books == v.Books && known.Chapters == v.Chapters && known.Verses == v.Verses && known.Checksum == v.Checksum {
+			v.Name = known.Name
+		}
+	}
+	return v
+}
+
+// Validate checks the parser's book table and returns every problem found, joined:
+//
+//   - book numbers run from 1 without gaps, and every book has a name;
+//   - every book has chapters numbered from 1 without gaps, each with verses;
+//   - no name or abbreviation refers to two books once standardised with
+//     StandardiseString, or ends in something other than a letter;
+//   - no abbreviation is a keyword ("end", "start", "ch", "v", "to", ...) or a
+//     separator ("and");
+//   - every name and singular name parses back to its own book.
+//
+// When versifications are given, the table must also match one of them, e.g.
+// Validate(KnownVersifications...). LoadStructure validates the tables it reads.
+func (p *BiblePassageParser) Validate(versifications ...Versification) error {
+	var errs []error
+	names := map[string]int{}
+	table := map[string]bool{} // every name of the table, valid or not
+	for num := 1; num <= len(p.structure); num++ {
+		bd, ok := p.structure[num]
+		if !ok {
+			errs = append(errs, fmt.Errorf("book numbers must run from 1 to %d: book %d is missing", len(p.structure), num))
+			continue
+		}
+		if strings.TrimSpace(bd.Name) == "" {
+			errs = append(errs, fmt.Errorf("book %d has no name", num))
+			continue
+		}
+		if len(bd.ChapterStructure) == 0 {
+			errs = append(errs, fmt.Errorf("%s has no chapters", bd.Name))
+		}
+		for ch := 1; ch <= len(bd.ChapterStructure); ch++ {
+			if n, ok := bd.ChapterStructure[ch]; !ok {
+				errs = append(errs, fmt.Errorf("%s has %d chapters but no chapter %d", bd.Name, len(bd.ChapterStructure), ch))
+			} else if n < 1 {
+				errs = append(errs, fmt.Errorf("%s %d has %d verses", bd.Name, ch, n))
+			}
+		}
+
+		for _, name := range append([]string{bd.Name, bd.SingularName}, bd.Abbreviations...) {
+			table[StandardiseString(name)] = true
+			if err := p.checkAbbreviation(name, num, names); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", bd.Name, err))
+				continue
+			}
+			names[StandardiseString(name)] = num
+		}
+	}
+	// abbreviations added with AddAbbreviation
+	p.mu.RLock()
+	added := []string{}
+	for s := range p.bookAbbr {
+		if !table[s] {
+			added = append(added, s)
+		}
+	}
+	sort.Strings(added)
+	for _, s := range added {
+		if err := p.checkAbbreviation(s, p.bookAbbr[s], nil); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.books[p.bookAbbr[s]].Name, err))
+		}
+	}
+	p.mu.RUnlock()
+	if len(errs) > 0 {
+		// names cannot be checked against a broken table
+		return errors.Join(errs...)
+	}
+
+	for _, b := range p.Books() {
+		for _, name := range []string{b.Name, b.SingularName} {
+			passages, err := p.parse(name+" 1:1", ParseContext{}, nil)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%q does not parse: %v", name, err))
+			} else if len(passages) != 1 || passages[0].From.Book != b || passages[0].From.Chapter != 1 || passages[0].From.Verse != 1 {
+				errs = append(errs, fmt.Errorf("%q does not parse as %s", name+" 1:1", b.Name))
+			}
+		}
+	}
+
+	if len(versifications) > 0 {
+		v := p.Versification()
+		matched := false
+		for _, known := range versifications {
+			matched = matched || known.Books == v.Books && known.Chapters == v.Chapters && known.Verses == v.Verses && known.Checksum == v.Checksum
+		}
+		if !matched {
+			errs = append(errs, fmt.Errorf("%d books with %d chapters and %d verses (checksum %#x) match no known versification", v.Books, v.Chapters, v.Verses, v.Checksum))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gotedo/bible-chapter-verse-parser/data"
+)
+
+func TestValidate_Default(t *testing.T) {
+	p := NewBiblePassageParser()
+	if err := p.Validate(KnownVersifications...); err != nil {
+		t.Errorf("built-in book table:\n%v", err)
+	}
+	if v := p.Versification(); v.Name != "English" || v.Verses != 31103 {
+		t.Errorf("Versification() = %+v, want English with 31103 verses", v)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	book := func(name string, abbr []string, chapters map[int]int) data.BookData {
+		return data.BookData{Name: name, SingularName: name, Abbreviations: abbr, ChapterStructure: chapters}
+	}
+	cases := []struct {
+		name      string
+		structure map[int]data.BookData
+		want      string
+	}{
+		{"gap in books", map[int]data.BookData{1: book("Genesis", nil, map[int]int{1: 31}), 3: book("Leviticus", nil, map[int]int{1: 17})}, "book 2 is missing"},
+		{"gap in chapters", map[int]data.BookData{1: book("Genesis", nil, map[int]int{1: 31, 3: 24})}, "Genesis has 2 chapters but no chapter 2"},
+		{"no verses", map[int]data.BookData{1: book("Genesis", nil, map[int]int{1: 0})}, "Genesis 1 has 0 verses"},
+		{"collision", map[int]data.BookData{1: book("Genesis", []string{"Ge."}, map[int]int{1: 31}), 2: book("Exodus", []string{"ge"}, map[int]int{1: 22})}, `Exodus: abbreviation "ge" already refers to Genesis`},
+		{"keyword", map[int]data.BookData{1: book("Genesis", []string{"V"}, map[int]int{1: 31})}, `Genesis: invalid abbreviation "V": it is a keyword`},
+		{"separator", map[int]data.BookData{1: book("Genesis", []string{"and"}, map[int]int{1: 31})}, `Genesis: invalid abbreviation "and": it is a keyword`},
+		{"digit", map[int]data.BookData{1: book("Genesis", []string{"gen 1"}, map[int]int{1: 31})}, `Genesis: invalid abbreviation "gen 1": it must end with a letter`},
+		{"name does not round-trip", map[int]data.BookData{1: book("Ruth's", nil, map[int]int{1: 31})}, `"Ruth's" does not parse`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := NewBiblePassageParser(WithStructure(c.structure)).Validate()
+			if err == nil || !strings.Contains(err.Error(), c.want) {
+				t.Errorf("Validate() = %v, want an error containing %q", err, c.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Versification(t *testing.T) {
+	structure := map[int]data.BookData{1: {Name: "Genesis", SingularName: "Genesis", ChapterStructure: map[int]int{1: 31, 2: 25}}}
+	p := NewBiblePassageParser(WithStructure(structure))
+	if err := p.Validate(); err != nil {
+		t.Fatalf("Validate() = %v", err)
+	}
+	if err := p.Validate(KnownVersifications...); err == nil {
+		t.Error("Validate(KnownVersifications...) accepted a two-chapter Bible")
+	}
+	v := p.Versification()
+	v.Name = "Genesis 1-2"
+	if err := p.Validate(v); err != nil {
+		t.Errorf("Validate(own versification) = %v", err)
+	}
+}
+
+func TestValidate_AddedAbbreviations(t *testing.T) {
+	structure, err := LoadStructure(strings.NewReader(structureJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewBiblePassageParser(WithStructure(structure))
+	if err := p.AddAbbreviation("Bereshit", "Genesis"); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+	// a bad abbreviation of the table is reported once, not again as an added one
+	bad := NewBiblePassageParser(WithStructure(map[int]data.BookData{1: {Name: "Genesis", SingularName: "Genesis", Abbreviations: []string{"gen 1"}, Chapters: []int{31}}}))
+	if err := bad.Validate(); err == nil || strings.Count(err.Error(), `"gen 1"`) != 1 {
+		t.Errorf("Validate() = %v, want \"gen 1\" reported once", err)
+	}
+	// an abbreviation that slipped past AddAbbreviation
+	p.bookAbbr["to"] = 1
+	if err := p.Validate(); err == nil || !strings.Contains(err.Error(), `Genesis: invalid abbreviation "to": it is a keyword`) {
+		t.Errorf("Validate() = %v, want the keyword reported", err)
+	}
+}
+
+// TestBuiltInAbbreviations covers the abbreviations corrected in the built-in table:
+// "i p" and "first p" belonged to both Peters and named 2 Peter, and "esth 1" and
+// "ecc1" could never match as they end with a digit.
+func TestBuiltInAbbreviations(t *testing.T) {
+	p := NewBiblePassageParser()
+	cases := map[string]string{
+		"1 P 1:1":      "1 Peter 1:1",
+		"I P 1:1":      "1 Peter 1:1",
+		"First P 1:1":  "1 Peter 1:1",
+		"2 P 1:1":      "2 Peter 1:1",
+		"II P 1:1":     "2 Peter 1:1",
+		"Second P 1:1": "2 Peter 1:1",
+		"Esth 1:1":     "Esther 1:1",
+		"Ecc 1:1":      "Ecclesiastes 1:1",
+	}
+	for in, want := range cases {
+		if got, err := p.Normalise(in); err != nil || got != want {
+			t.Errorf("Normalise(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+}
+
+func TestValidate_Cache(t *testing.T) {
+	p := NewBiblePassageParser(WithCache(8))
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if got := p.CacheStats(); got != (CacheStats{Capacity: 8}) {
+		t.Errorf("CacheStats() after Validate = %+v, want an untouched cache", got)
+	}
+}